@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.0.0-20250101123456789012", "1.0.0-20250101123456789012"},
+		{"18446744073709551616.0.0", "18446744073709551616.0.0"},
+		{"1.99999999999999999999999.3-rc.123456789012345678901234+build", "1.99999999999999999999999.3-rc.123456789012345678901234+build"},
+		{"18446744073709551615.0.0", "18446744073709551615.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := semver.ParseBig(tt.input)
+			if err != nil {
+				t.Fatalf("ParseBig(%q) error = %v", tt.input, err)
+			}
+
+			if got := v.String(); got != tt.want {
+				t.Errorf("ParseBig(%q).String() = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBigInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"1.2",
+		"01.2.3",
+		"1.2.3-018446744073709551616",
+		"18446744073709551616.0.0.0",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if v, err := semver.ParseBig(input); err == nil {
+				t.Errorf("ParseBig(%q) = %v, want error", input, v)
+			}
+		})
+	}
+}
+
+func TestParseLaxBig(t *testing.T) {
+	t.Parallel()
+
+	v, err := semver.ParseLaxBig("v18446744073709551616")
+	if err != nil {
+		t.Fatalf("ParseLaxBig() error = %v", err)
+	}
+
+	if got, want := v.String(), "18446744073709551616.0.0"; got != want {
+		t.Errorf("ParseLaxBig() = %q, want %q", got, want)
+	}
+}
+
+func TestParseRejectsBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"18446744073709551616.0.0",
+		"1.0.0-20250101123456789012",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := semver.Parse(input)
+
+			var pe *semver.ParseError
+			if !errors.As(err, &pe) || pe.Kind != semver.KindOverflow {
+				t.Errorf("Parse(%q) error = %v, want overflow", input, err)
+			}
+		})
+	}
+}
+
+func TestVersionCompareBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"18446744073709551616.0.0", "18446744073709551615.0.0", 1},
+		{"18446744073709551616.0.0", "18446744073709551616.0.0", 0},
+		{"18446744073709551616.0.0", "99999999999999999999.0.0", -1},
+		{"100000000000000000000.0.0", "99999999999999999999.0.0", 1},
+		{"1.18446744073709551616.0", "1.18446744073709551616.1", -1},
+		{"1.0.0-18446744073709551616", "1.0.0-18446744073709551615", 1},
+		{"1.0.0-18446744073709551616", "1.0.0-18446744073709551617", -1},
+		{"1.0.0-18446744073709551616", "1.0.0-alpha", -1},
+		{"1.0.0-18446744073709551616", "1.0.0", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			a := semver.MustParseBig(tt.a)
+			b := semver.MustParseBig(tt.b)
+
+			if got := a.Compare(b); got != tt.want {
+				t.Errorf("Version{%q}.Compare(%q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := b.Compare(a); got != -tt.want {
+				t.Errorf("Version{%q}.Compare(%q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+
+			if got := a.Equal(b); got != (tt.want == 0) {
+				t.Errorf("Version{%q}.Equal(%q) = %t, want %t", tt.a, tt.b, got, tt.want == 0)
+			}
+
+			ea := a.AppendSortable(nil)
+			eb := b.AppendSortable(nil)
+
+			if got := bytes.Compare(ea, eb); got != tt.want {
+				t.Errorf("bytes.Compare(sortable(%q), sortable(%q)) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSortableBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"18446744073709551616.0.0",
+		"1.99999999999999999999999.3-rc.123456789012345678901234+build",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParseBig(input)
+
+			got, err := semver.ParseSortable(v.AppendSortable(nil))
+			if err != nil {
+				t.Fatalf("ParseSortable() error = %v", err)
+			}
+
+			if !got.StrictEqual(v) || got.String() != input {
+				t.Errorf("ParseSortable() = %q, want %q", got, input)
+			}
+		})
+	}
+}
+
+func TestVersionIncBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v     string
+		major string
+		minor string
+		patch string
+		pre   string
+	}{
+		{
+			"99999999999999999999.1.2",
+			"100000000000000000000.0.0",
+			"99999999999999999999.2.0",
+			"99999999999999999999.1.3",
+			"99999999999999999999.1.3-0",
+		},
+		{
+			"1.2.18446744073709551616",
+			"2.0.0",
+			"1.3.0",
+			"1.2.18446744073709551617",
+			"1.2.18446744073709551617-0",
+		},
+		{
+			"1.2.3-rc.18446744073709551619",
+			"2.0.0",
+			"1.3.0",
+			"1.2.3",
+			"1.2.3-rc.18446744073709551620",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParseBig(tt.v)
+
+			if got, err := v.IncMajor(); err != nil || got.String() != tt.major {
+				t.Errorf("Version{%q}.IncMajor() = %v, %v, want %q", tt.v, got, err, tt.major)
+			}
+
+			if got, err := v.IncMinor(); err != nil || got.String() != tt.minor {
+				t.Errorf("Version{%q}.IncMinor() = %v, %v, want %q", tt.v, got, err, tt.minor)
+			}
+
+			if got, err := v.IncPatch(); err != nil || got.String() != tt.patch {
+				t.Errorf("Version{%q}.IncPatch() = %v, %v, want %q", tt.v, got, err, tt.patch)
+			}
+
+			w, err := v.IncPrerelease("")
+			if err != nil {
+				t.Fatalf("Version{%q}.IncPrerelease() error = %v", tt.v, err)
+			}
+
+			if got := w.String(); got != tt.pre {
+				t.Errorf("Version{%q}.IncPrerelease() = %q, want %q", tt.v, got, tt.pre)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"math"
+)
+
+// Finalize returns a new Version that is the release version of v, in other
+// words v without the pre-release identifiers and build metadata. For example,
+// "1.2.3-rc.1" is finalized to "1.2.3".
+func (v *Version) Finalize() *Version {
+	return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, big: v.big}
+}
+
+// IncMajor returns a new Version with the major version of v incremented and
+// the minor and patch versions set to zero. If v is a pre-release of a major
+// version, like "2.0.0-rc.1", IncMajor returns the release of it, "2.0.0".
+// The pre-release identifiers and build metadata are not included in
+// the returned Version. IncMajor returns an error that wraps [ErrOverflow] if
+// the major version would overflow.
+func (v *Version) IncMajor() (*Version, error) {
+	if len(v.Prerelease) > 0 && v.Minor == 0 && v.Patch == 0 {
+		return v.Finalize(), nil
+	}
+
+	var err error
+
+	d := v.bigDigits()
+	w := &Version{Minor: 0, Patch: 0}
+
+	if w.Major, d[0], err = incNumber(v.Major, d[0]); err != nil {
+		return nil, fmt.Errorf("%w: major version of %q", err, v)
+	}
+
+	w.setBigDigits([3]string{d[0], "", ""})
+
+	return w, nil
+}
+
+// IncMinor returns a new Version with the minor version of v incremented and
+// the patch version set to zero. If v is a pre-release of a minor version, like
+// "1.2.0-rc.1", IncMinor returns the release of it, "1.2.0". The pre-release
+// identifiers and build metadata are not included in the returned Version.
+// IncMinor returns an error that wraps [ErrOverflow] if the minor version would
+// overflow.
+func (v *Version) IncMinor() (*Version, error) {
+	if len(v.Prerelease) > 0 && v.Patch == 0 {
+		return v.Finalize(), nil
+	}
+
+	var err error
+
+	d := v.bigDigits()
+	w := &Version{Major: v.Major, Patch: 0}
+
+	if w.Minor, d[1], err = incNumber(v.Minor, d[1]); err != nil {
+		return nil, fmt.Errorf("%w: minor version of %q", err, v)
+	}
+
+	w.setBigDigits([3]string{d[0], d[1], ""})
+
+	return w, nil
+}
+
+// IncPatch returns a new Version with the patch version of v incremented. If v
+// is a pre-release, like "1.2.3-rc.1", IncPatch returns the release of it,
+// "1.2.3". The pre-release identifiers and build metadata are not included in
+// the returned Version. IncPatch returns an error that wraps [ErrOverflow] if
+// the patch version would overflow.
+func (v *Version) IncPatch() (*Version, error) {
+	if len(v.Prerelease) > 0 {
+		return v.Finalize(), nil
+	}
+
+	var err error
+
+	d := v.bigDigits()
+	w := &Version{Major: v.Major, Minor: v.Minor}
+
+	if w.Patch, d[2], err = incNumber(v.Patch, d[2]); err != nil {
+		return nil, fmt.Errorf("%w: patch version of %q", err, v)
+	}
+
+	w.setBigDigits(d)
+
+	return w, nil
+}
+
+// IncPrerelease returns a new Version that is the next pre-release of v in
+// the given channel. The build metadata is not included in the returned
+// Version.
+//
+// If v is not a pre-release, the patch version is incremented and
+// the pre-release is set to the channel followed by "0", so "1.2.3" is
+// incremented to "1.2.4-beta.0" in the channel "beta". If v is a pre-release in
+// the same channel, the last numeric identifier is incremented, so
+// "1.2.3-rc.4" is incremented to "1.2.3-rc.5" in the channel "rc". If v is
+// a pre-release in another channel, the pre-release is set to the channel
+// followed by "0". If the channel is empty, the last numeric identifier of
+// the pre-release is incremented, or "0" is appended to the pre-release if it
+// has no numeric identifiers.
+//
+// IncPrerelease returns an error if the channel is not a valid pre-release
+// identifier, or an error that wraps [ErrOverflow] if a number in the version
+// would overflow.
+func (v *Version) IncPrerelease(channel string) (*Version, error) {
+	var ch PrereleaseIdentifier
+
+	if channel != "" {
+		var err error
+
+		ch, err = parsePrereleaseIdentifier(channel, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pre-release channel %q: %w", channel, err)
+		}
+	}
+
+	w := &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, big: v.big}
+
+	if len(v.Prerelease) == 0 {
+		var err error
+
+		d := v.bigDigits()
+
+		if w.Patch, d[2], err = incNumber(v.Patch, d[2]); err != nil {
+			return nil, fmt.Errorf("%w: patch version of %q", err, v)
+		}
+
+		w.setBigDigits(d)
+		w.Prerelease = Prerelease{NumericIdentifier(0)}
+	} else {
+		pre, err := incPrerelease(v.Prerelease)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment the pre-release of %q: %w", v, err)
+		}
+
+		w.Prerelease = pre
+	}
+
+	if channel == "" {
+		return w, nil
+	}
+
+	// Keep the incremented pre-release only if it is already in the channel
+	// and the channel is followed by a number.
+	if !identifiersEqual(w.Prerelease[0], ch) || len(w.Prerelease) < 2 || !w.Prerelease[1].IsNumeric() {
+		w.Prerelease = Prerelease{ch, NumericIdentifier(0)}
+	}
+
+	return w, nil
+}
+
+// incPrerelease returns a copy of p with the last numeric identifier
+// incremented. If p has no numeric identifiers, "0" is appended to the copy.
+func incPrerelease(p Prerelease) (Prerelease, error) {
+	pre := make(Prerelease, len(p), len(p)+1)
+	copy(pre, p)
+
+	for i := len(pre) - 1; i >= 0; i-- {
+		n := identifierOf(pre[i])
+
+		switch {
+		case n.IsAlphanumeric():
+			continue
+		case n.s != "":
+			pre[i] = bigNumericIdentifier(incDigits(n.s))
+		case n.n == math.MaxUint64:
+			return nil, fmt.Errorf("%w: pre-release identifier %d", ErrOverflow, n.n)
+		default:
+			pre[i] = NumericIdentifier(n.n + 1)
+		}
+
+		return pre, nil
+	}
+
+	return append(pre, NumericIdentifier(0)), nil
+}
+
+// incNumber returns the number incremented by one. If the number does not fit
+// in uint64, its digits are given in digits and the incremented digits are
+// returned. Otherwise incNumber returns [ErrOverflow] if u would overflow.
+func incNumber(u uint64, digits string) (uint64, string, error) {
+	if digits != "" {
+		return math.MaxUint64, incDigits(digits), nil
+	}
+
+	if u == math.MaxUint64 {
+		return 0, "", ErrOverflow
+	}
+
+	return u + 1, "", nil
+}
+
+// incDigits returns the decimal number s incremented by one.
+func incDigits(s string) string {
+	b := []byte(s)
+
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != '9' {
+			b[i]++
+
+			return string(b)
+		}
+
+		b[i] = '0'
+	}
+
+	return "1" + string(b)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionInc(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v        string
+		major    string
+		minor    string
+		patch    string
+		finalize string
+	}{
+		{"1.2.3", "2.0.0", "1.3.0", "1.2.4", "1.2.3"},
+		{"1.2.3+build", "2.0.0", "1.3.0", "1.2.4", "1.2.3"},
+		{"1.2.3-rc.1", "2.0.0", "1.3.0", "1.2.3", "1.2.3"},
+		{"1.2.0-rc.1", "2.0.0", "1.2.0", "1.2.0", "1.2.0"},
+		{"2.0.0-rc.1", "2.0.0", "2.0.0", "2.0.0", "2.0.0"},
+		{"0.0.0", "1.0.0", "0.1.0", "0.0.1", "0.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt.v)
+			orig := v.String()
+
+			if got, err := v.IncMajor(); err != nil || got.String() != tt.major {
+				t.Errorf("Version{%q}.IncMajor() = %v, %v, want %q", tt.v, got, err, tt.major)
+			}
+
+			if got, err := v.IncMinor(); err != nil || got.String() != tt.minor {
+				t.Errorf("Version{%q}.IncMinor() = %v, %v, want %q", tt.v, got, err, tt.minor)
+			}
+
+			if got, err := v.IncPatch(); err != nil || got.String() != tt.patch {
+				t.Errorf("Version{%q}.IncPatch() = %v, %v, want %q", tt.v, got, err, tt.patch)
+			}
+
+			if got := v.Finalize().String(); got != tt.finalize {
+				t.Errorf("Version{%q}.Finalize() = %q, want %q", tt.v, got, tt.finalize)
+			}
+
+			if v.String() != orig {
+				t.Errorf("Version{%q} was modified to %q", orig, v.String())
+			}
+		})
+	}
+}
+
+func TestVersionIncPrerelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v       string
+		channel string
+		want    string
+		wantErr error
+	}{
+		{"1.2.3-rc.4", "rc", "1.2.3-rc.5", nil},
+		{"1.2.3", "beta", "1.2.4-beta.0", nil},
+		{"1.2.3+build", "beta", "1.2.4-beta.0", nil},
+		{"1.2.3-alpha.4", "beta", "1.2.3-beta.0", nil},
+		{"1.2.3-rc", "rc", "1.2.3-rc.0", nil},
+		{"1.2.3-rc.foo", "rc", "1.2.3-rc.0", nil},
+		{"1.2.3-rc.1.foo", "rc", "1.2.3-rc.2.foo", nil},
+		{"1.2.3-rc.1.2", "rc", "1.2.3-rc.1.3", nil},
+		{"1.2.3", "", "1.2.4-0", nil},
+		{"1.2.3-alpha", "", "1.2.3-alpha.0", nil},
+		{"1.2.3-alpha.9", "", "1.2.3-alpha.10", nil},
+		{"1.2.3-0", "", "1.2.3-1", nil},
+		{"1.2.3", "be_ta", "", semver.ErrInvalidVersion},
+		{"1.2.3", "01", "", semver.ErrInvalidVersion},
+		{"1.2.3-18446744073709551615", "", "", semver.ErrOverflow},
+		{"1.2.18446744073709551615", "rc", "", semver.ErrOverflow},
+		{"1.2.18446744073709551615-rc.1", "rc", "1.2.18446744073709551615-rc.2", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v+"/"+tt.channel, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt.v)
+			orig := v.String()
+
+			got, err := v.IncPrerelease(tt.channel)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf(
+						"Version{%q}.IncPrerelease(%q) = %v, %v, want %v",
+						tt.v,
+						tt.channel,
+						got,
+						err,
+						tt.wantErr,
+					)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Version{%q}.IncPrerelease(%q) failed unexpectedly: %v", tt.v, tt.channel, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("Version{%q}.IncPrerelease(%q) = %q, want %q", tt.v, tt.channel, got, tt.want)
+			}
+
+			if v.String() != orig {
+				t.Errorf("Version{%q} was modified to %q", orig, v.String())
+			}
+		})
+	}
+}
+
+func TestVersionIncOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v     string
+		major bool
+		minor bool
+		patch bool
+		pre   bool
+	}{
+		{"18446744073709551615.0.0", true, false, false, false},
+		{"18446744073709551615.0.0-rc.1", false, false, false, false},
+		{"18446744073709551615.1.0-rc.1", true, false, false, false},
+		{"1.18446744073709551615.0", false, true, false, false},
+		{"1.18446744073709551615.0-rc.1", false, false, false, false},
+		{"1.18446744073709551615.1-rc.1", false, true, false, false},
+		{"1.2.18446744073709551615", false, false, true, true},
+		{"1.2.18446744073709551615-rc.1", false, false, false, false},
+		{"1.2.3-18446744073709551615", false, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt.v)
+
+			incs := []struct {
+				name     string
+				overflow bool
+				inc      func() (*semver.Version, error)
+			}{
+				{"IncMajor", tt.major, v.IncMajor},
+				{"IncMinor", tt.minor, v.IncMinor},
+				{"IncPatch", tt.patch, v.IncPatch},
+				{"IncPrerelease", tt.pre, func() (*semver.Version, error) { return v.IncPrerelease("") }},
+			}
+
+			for _, inc := range incs {
+				got, err := inc.inc()
+
+				switch {
+				case inc.overflow && !errors.Is(err, semver.ErrOverflow):
+					t.Errorf("Version{%q}.%s() = %v, %v, want ErrOverflow", tt.v, inc.name, got, err)
+				case !inc.overflow && err != nil:
+					t.Errorf("Version{%q}.%s() failed unexpectedly: %v", tt.v, inc.name, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MustParseCargoConstraint parses the given Cargo version requirement string
+// into a Constraint and panics if it encounters an error.
+func MustParseCargoConstraint(s string) *Constraint {
+	c, err := ParseCargoConstraint(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a constraint: %v", s, err))
+	}
+
+	return c
+}
+
+// ParseCargoConstraint parses the given string into a Constraint using
+// the version requirement syntax of Cargo, the Rust package manager.
+// The comparators are separated by commas and all of them must be satisfied.
+// The syntax supports the operators "=", "<", "<=", ">", ">=", "~", and "^",
+// and the wildcards like "1.2.*" and "*". Unlike in npm, a version without
+// an operator is a caret requirement, so "1.2" means "^1.2". The version
+// strings in the requirement may not have a 'v' prefix.
+//
+// A version with pre-release identifiers satisfies the constraint only if some
+// comparator has a version with the same core version and pre-release
+// identifiers.
+func ParseCargoConstraint(s string) (*Constraint, error) {
+	parts := strings.Split(s, ",")
+	set := make([]comparator, 0, len(parts))
+
+	for _, part := range parts {
+		cmps, err := parseCargoComparator(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Cargo constraint %q: %w", s, err)
+		}
+
+		set = append(set, cmps...)
+	}
+
+	return &Constraint{sets: [][]comparator{set}, dialect: cargo}, nil
+}
+
+// parseCargoComparator parses a single comparator of a Cargo version
+// requirement and reduces it to primitive comparators.
+func parseCargoComparator(s string) ([]comparator, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty comparator", ErrInvalidConstraint)
+	}
+
+	op, rest := cutOperator(s)
+	rest = strings.TrimLeft(rest, " \t")
+
+	if rest == "" {
+		return nil, fmt.Errorf("%w: missing version in %q", ErrInvalidConstraint, s)
+	}
+
+	if !isDigit(rest[0]) && !isWildcard(rest[:1]) {
+		return nil, fmt.Errorf(
+			"%w: version in %q does not start with a digit or a wildcard",
+			ErrInvalidConstraint,
+			s,
+		)
+	}
+
+	p, err := parsePartial(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.n < 3 && strings.ContainsAny(rest, "-+") { //nolint:mnd // full version
+		return nil, fmt.Errorf(
+			"%w: partial version %q may not have pre-release or build metadata",
+			ErrInvalidConstraint,
+			rest,
+		)
+	}
+
+	wildcard := hasWildcard(rest)
+
+	if wildcard && op != "" && op != "=" {
+		return nil, fmt.Errorf(
+			"%w: wildcard may only be used without an operator or with '=' in %q",
+			ErrInvalidConstraint,
+			s,
+		)
+	}
+
+	switch op {
+	case "":
+		if wildcard {
+			return xRange(p), nil
+		}
+
+		return caretRange(p), nil
+	case "=":
+		return xRange(p), nil
+	case "~":
+		return tildeRange(p), nil
+	case "^":
+		return caretRange(p), nil
+	case ">":
+		return greaterRange(p), nil
+	case ">=":
+		return []comparator{{opGreaterEqual, p.ver}}, nil
+	case "<":
+		return lessRange(p), nil
+	case "<=":
+		return lessEqualRange(p), nil
+	default:
+		return nil, fmt.Errorf("%w: invalid operator %q in %q", ErrInvalidConstraint, op, s)
+	}
+}
+
+// hasWildcard reports whether the core version of the partial version s has
+// wildcards.
+func hasWildcard(s string) bool {
+	end := strings.IndexAny(s, "-+")
+	if end < 0 {
+		end = len(s)
+	}
+
+	for c := range strings.SplitSeq(s[:end], ".") {
+		if isWildcard(c) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseCargoConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c       string
+		want    string
+		wantErr bool
+	}{
+		{"*", ">=0.0.0", false},
+		{"1.2.3", ">=1.2.3, <2.0.0-0", false},
+		{"1.2", ">=1.2.0, <2.0.0-0", false},
+		{"1", ">=1.0.0, <2.0.0-0", false},
+		{"0.2.3", ">=0.2.3, <0.3.0-0", false},
+		{"0.0.3", ">=0.0.3, <0.0.4-0", false},
+		{"0.0", ">=0.0.0, <0.1.0-0", false},
+		{"0", ">=0.0.0, <1.0.0-0", false},
+		{"=1.2.3", "=1.2.3", false},
+		{"=1.2", ">=1.2.0, <1.3.0-0", false},
+		{"~1.2", ">=1.2.0, <1.3.0-0", false},
+		{"~1", ">=1.0.0, <2.0.0-0", false},
+		{"1.*", ">=1.0.0, <2.0.0-0", false},
+		{"1.2.*", ">=1.2.0, <1.3.0-0", false},
+		{">=1, <3", ">=1.0.0, <3.0.0-0", false},
+		{">= 1.2.0, < 1.5", ">=1.2.0, <1.5.0-0", false},
+		{"1.2.3-beta.1", ">=1.2.3-beta.1, <2.0.0-0", false},
+		{"", "", true},
+		{"1.2,", "", true},
+		{"v1.2.3", "", true},
+		{"1.2-beta", "", true},
+		{">=1.*", "", true},
+		{"1 || 2", "", true},
+		{"~>1.2", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseCargoConstraint(tt.c)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseCargoConstraint(%q) = %v, want error", tt.c, got)
+				}
+
+				if !errors.Is(err, semver.ErrInvalidConstraint) {
+					t.Errorf(
+						"ParseCargoConstraint(%q) error = %v, want ErrInvalidConstraint",
+						tt.c,
+						err,
+					)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseCargoConstraint(%q) failed unexpectedly: %v", tt.c, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseCargoConstraint(%q).String() = %q, want %q", tt.c, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionSatisfiesCargo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"1.2", "1.9.0", true},
+		{"1.2", "1.2.0", true},
+		{"1.2", "2.0.0", false},
+		{"1.2.3", "1.2.4", true},
+		{"0.2.3", "0.2.9", true},
+		{"0.2.3", "0.3.0", false},
+		{"0.0.3", "0.0.3", true},
+		{"0.0.3", "0.0.4", false},
+		{"=1.2.3", "1.2.4", false},
+		{"=1.2", "1.2.9", true},
+		{"=1.2", "1.3.0", false},
+		{"~1.2", "1.2.5", true},
+		{"~1.2", "1.3.0", false},
+		{">=1, <3", "2.9.9", true},
+		{">=1, <3", "3.0.0", false},
+		{">=1, <3", "3.0.0-alpha", false},
+		{"*", "0.0.1", true},
+		{"*", "1.0.0-alpha", false},
+		{"1.2.3-beta.1", "1.2.3-beta.2", true},
+		{"1.2.3-beta.1", "1.2.4-beta.2", false},
+		{"1.2.3-beta.1", "1.2.3", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			c := semver.MustParseCargoConstraint(tt.c)
+			v := semver.MustParse(tt.v)
+
+			if got := v.Satisfies(c); got != tt.want {
+				t.Errorf("Version{%q}.Satisfies(%q) = %v, want %v", tt.v, tt.c, got, tt.want)
+			}
+		})
+	}
+}
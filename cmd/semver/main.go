@@ -0,0 +1,522 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Semver is a command-line tool for working with semantic versions.
+//
+// Usage:
+//
+//	semver <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	validate [version...]           check that the versions are valid
+//	compare <a> <b>                 print -1, 0, or 1 as a is less than, equal to, or greater than b
+//	sort [version...]               print the versions in increasing order
+//	max [version...]                print the greatest version
+//	bump <part> <version>           increment the major, minor, patch, or pre part of the version
+//	satisfies <range> [version...]  print the versions that satisfy the range
+//
+// The commands that take a list of versions read the versions, separated by
+// white space, from the standard input if no versions are given as arguments.
+// The versions are printed in the form they were given in.
+//
+// All of the commands accept the following flags:
+//
+//	-json
+//		write the output as JSON
+//	-lax
+//		accept partial versions like "v1" and "1.2"
+//
+// The output of the commands in JSON is the same value that is printed
+// otherwise: a number for compare, a string for max and bump, and an array of
+// strings for sort and satisfies. The output of validate is an array that
+// contains an object for each version.
+//
+// Semver exits with status 1 if validate finds invalid versions or if no
+// version satisfies the range in satisfies, and with status 2 on other errors.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/anttikivi/semver"
+)
+
+// Exit statuses of the program.
+const (
+	exitOK    = 0
+	exitFalse = 1
+	exitError = 2
+)
+
+var (
+	// errFalse is returned by the commands whose check failed. It makes
+	// the program exit with exitFalse without printing an error.
+	errFalse = errors.New("check failed")
+
+	// errUsage is returned by the commands when they are given invalid
+	// arguments. It makes the program print the usage of the command.
+	errUsage = errors.New("invalid arguments")
+)
+
+// An app holds the input, the output, and the flags of the program.
+type app struct {
+	stdin   io.Reader
+	stdout  io.Writer
+	stderr  io.Writer
+	json    bool
+	lax     bool
+	reverse bool
+	unique  bool
+	cargo   bool
+	preid   string
+}
+
+// A command is a subcommand of the program.
+type command struct {
+	name    string
+	args    string
+	summary string
+	flags   func(fs *flag.FlagSet, a *app)
+	run     func(a *app, args []string) error
+}
+
+// A validation is the JSON output of the validate command for a version.
+type validation struct {
+	Version string `json:"version"`
+	Valid   bool   `json:"valid"`
+	Error   string `json:"error,omitempty"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the program with the given arguments and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+
+		return exitError
+	}
+
+	if args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		usage(stdout)
+
+		return exitOK
+	}
+
+	i := slices.IndexFunc(commands(), func(c command) bool { return c.name == args[0] })
+	if i < 0 {
+		fmt.Fprintf(stderr, "semver: unknown command %q\n", args[0])
+		usage(stderr)
+
+		return exitError
+	}
+
+	cmd := commands()[i]
+	a := &app{stdin: stdin, stdout: stdout, stderr: stderr} //nolint:exhaustruct // flags are set below
+
+	fs := flag.NewFlagSet("semver "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: semver %s [flags] %s\n\n%s\n\nflags:\n", cmd.name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	fs.BoolVar(&a.json, "json", false, "write the output as JSON")
+	fs.BoolVar(&a.lax, "lax", false, "accept partial versions like \"v1\" and \"1.2\"")
+
+	if cmd.flags != nil {
+		cmd.flags(fs, a)
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitError
+	}
+
+	err := cmd.run(a, fs.Args())
+
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, errFalse):
+		return exitFalse
+	case errors.Is(err, errUsage):
+		fs.Usage()
+
+		return exitError
+	default:
+		fmt.Fprintf(stderr, "semver: %v\n", err)
+
+		return exitError
+	}
+}
+
+// commands returns the subcommands of the program.
+func commands() []command {
+	return []command{
+		{
+			name:    "validate",
+			args:    "[version...]",
+			summary: "Validate checks that the versions are valid.",
+			flags:   nil,
+			run:     (*app).validate,
+		},
+		{
+			name:    "compare",
+			args:    "<a> <b>",
+			summary: "Compare prints -1, 0, or 1 as a is less than, equal to, or greater than b.",
+			flags:   nil,
+			run:     (*app).compare,
+		},
+		{
+			name:    "sort",
+			args:    "[version...]",
+			summary: "Sort prints the versions in increasing order.",
+			flags: func(fs *flag.FlagSet, a *app) {
+				fs.BoolVar(&a.reverse, "reverse", false, "print the versions in decreasing order")
+				fs.BoolVar(&a.unique, "unique", false, "print only the first of equal versions")
+			},
+			run: (*app).sort,
+		},
+		{
+			name:    "max",
+			args:    "[version...]",
+			summary: "Max prints the greatest version.",
+			flags:   nil,
+			run:     (*app).max,
+		},
+		{
+			name:    "bump",
+			args:    "major|minor|patch|pre <version>",
+			summary: "Bump prints the version with the given part incremented.",
+			flags: func(fs *flag.FlagSet, a *app) {
+				fs.StringVar(&a.preid, "preid", "", "use the pre-release channel `id` when bumping pre")
+			},
+			run: (*app).bump,
+		},
+		{
+			name:    "satisfies",
+			args:    "<range> [version...]",
+			summary: "Satisfies prints the versions that satisfy the range.",
+			flags: func(fs *flag.FlagSet, a *app) {
+				fs.BoolVar(&a.cargo, "cargo", false, "parse the range using the syntax of Cargo instead of npm")
+			},
+			run: (*app).satisfies,
+		},
+	}
+}
+
+// usage writes the usage of the program to w.
+func usage(w io.Writer) {
+	fmt.Fprint(w, "usage: semver <command> [flags] [arguments]\n\ncommands:\n")
+
+	for _, c := range commands() {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+
+	fmt.Fprint(w, "\nRun \"semver <command> -h\" for the usage of a command.\n")
+}
+
+// validate runs the validate command.
+func (a *app) validate(args []string) error {
+	texts, err := a.inputs(args)
+	if err != nil {
+		return err
+	}
+
+	if len(texts) == 0 {
+		return errors.New("no versions to validate")
+	}
+
+	check := semver.Validate
+	if a.lax {
+		check = semver.ValidateLax
+	}
+
+	results := make([]validation, 0, len(texts))
+	valid := true
+
+	for _, s := range texts {
+		r := validation{Version: s, Valid: true, Error: ""}
+
+		if err := check(s); err != nil {
+			r.Valid = false
+			r.Error = err.Error()
+			valid = false
+
+			if !a.json {
+				fmt.Fprintf(a.stderr, "semver: %v\n", err)
+			}
+		}
+
+		results = append(results, r)
+	}
+
+	if a.json {
+		if err := a.writeJSON(results); err != nil {
+			return err
+		}
+	}
+
+	if !valid {
+		return errFalse
+	}
+
+	return nil
+}
+
+// compare runs the compare command.
+func (a *app) compare(args []string) error {
+	if len(args) != 2 { //nolint:mnd // the two versions
+		return errUsage
+	}
+
+	v, err := a.parse(args[0])
+	if err != nil {
+		return err
+	}
+
+	w, err := a.parse(args[1])
+	if err != nil {
+		return err
+	}
+
+	return a.write(v.Compare(w))
+}
+
+// sort runs the sort command.
+func (a *app) sort(args []string) error {
+	versions, err := a.versions(args)
+	if err != nil {
+		return err
+	}
+
+	if a.reverse {
+		// Sorting with the reversed comparison keeps the input order of
+		// the equal versions, unlike reversing the sorted slice.
+		slices.SortStableFunc(versions, func(v, w *semver.Version) int {
+			return -v.Compare(w)
+		})
+	} else {
+		versions.SortStable()
+	}
+
+	if a.unique {
+		versions = versions.Compact()
+	}
+
+	return a.writeList(versions)
+}
+
+// max runs the max command.
+func (a *app) max(args []string) error {
+	versions, err := a.versions(args)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return errors.New("no versions")
+	}
+
+	return a.write(versions.Max().Original())
+}
+
+// bump runs the bump command.
+func (a *app) bump(args []string) error {
+	if len(args) != 2 { //nolint:mnd // the part and the version
+		return errUsage
+	}
+
+	v, err := a.parse(args[1])
+	if err != nil {
+		return err
+	}
+
+	var w *semver.Version
+
+	switch args[0] {
+	case "major":
+		w, err = v.IncMajor()
+	case "minor":
+		w, err = v.IncMinor()
+	case "patch":
+		w, err = v.IncPatch()
+	case "pre":
+		w, err = v.IncPrerelease(a.preid)
+	default:
+		return fmt.Errorf("unknown part %q, want major, minor, patch, or pre", args[0])
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return a.write(w.FormatLike(v))
+}
+
+// satisfies runs the satisfies command.
+func (a *app) satisfies(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	parse := semver.ParseConstraint
+	if a.cargo {
+		parse = semver.ParseCargoConstraint
+	}
+
+	c, err := parse(args[0])
+	if err != nil {
+		return err
+	}
+
+	versions, err := a.versions(args[1:])
+	if err != nil {
+		return err
+	}
+
+	var matches semver.Versions
+
+	for _, v := range versions {
+		if v.Satisfies(c) {
+			matches = append(matches, v)
+		}
+	}
+
+	if err := a.writeList(matches); err != nil {
+		return err
+	}
+
+	if len(matches) == 0 {
+		return errFalse
+	}
+
+	return nil
+}
+
+// parse parses the version string s according to the flags.
+func (a *app) parse(s string) (*semver.Version, error) {
+	p := semver.Parser{KeepOriginal: true} //nolint:exhaustruct // defaults of the strict parser
+	if a.lax {
+		p.MinCore = 1
+	}
+
+	return p.Parse(s)
+}
+
+// inputs returns the version strings given as arguments or, if there are no
+// arguments, read from the standard input.
+func (a *app) inputs(args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	var texts []string
+
+	s := bufio.NewScanner(a.stdin)
+	s.Split(semver.ScanVersions)
+
+	for s.Scan() {
+		texts = append(texts, s.Text())
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read versions: %w", err)
+	}
+
+	return texts, nil
+}
+
+// versions parses the versions given as arguments or, if there are no
+// arguments, read from the standard input.
+func (a *app) versions(args []string) (semver.Versions, error) {
+	texts, err := a.inputs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(semver.Versions, 0, len(texts))
+
+	for _, s := range texts {
+		v, err := a.parse(s)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
+
+// write writes a single value as JSON or as a line of text.
+func (a *app) write(v any) error {
+	if a.json {
+		return a.writeJSON(v)
+	}
+
+	if _, err := fmt.Fprintln(a.stdout, v); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	return nil
+}
+
+// writeList writes the versions in the form they were given in as a JSON array
+// or as lines of text.
+func (a *app) writeList(versions semver.Versions) error {
+	texts := make([]string, 0, len(versions))
+	for _, v := range versions {
+		texts = append(texts, v.Original())
+	}
+
+	if a.json {
+		return a.writeJSON(texts)
+	}
+
+	for _, s := range texts {
+		if _, err := fmt.Fprintln(a.stdout, s); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// writeJSON writes v as JSON.
+func (a *app) writeJSON(v any) error {
+	if err := json.NewEncoder(a.stdout).Encode(v); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		want   string
+		status int
+	}{
+		{"no command", nil, "", "", exitError},
+		{"unknown command", []string{"frobnicate"}, "", "", exitError},
+		{"command help", []string{"sort", "-h"}, "", "", exitOK},
+		{"unknown flag", []string{"sort", "-frobnicate"}, "", "", exitError},
+
+		{"validate", []string{"validate", "1.2.3", "v2.0.0-rc.1"}, "", "", exitOK},
+		{"validate invalid", []string{"validate", "1.2.3", "1.2"}, "", "", exitFalse},
+		{"validate lax", []string{"validate", "-lax", "1.2", "v3"}, "", "", exitOK},
+		{"validate stdin", []string{"validate"}, "1.2.3\n1.2.4\n", "", exitOK},
+		{"validate empty", []string{"validate"}, "", "", exitError},
+		{
+			"validate json",
+			[]string{"validate", "-json", "1.2.3", "1.2"},
+			"",
+			`[{"version":"1.2.3","valid":true},{"version":"1.2","valid":false,"error":"invalid semantic version \"1.2\": not enough core version numbers in core version at offset 3"}]` + "\n",
+			exitFalse,
+		},
+
+		{"compare less", []string{"compare", "1.2.3", "1.10.0"}, "", "-1\n", exitOK},
+		{"compare equal", []string{"compare", "1.2.3+a", "v1.2.3+b"}, "", "0\n", exitOK},
+		{"compare greater", []string{"compare", "1.2.3", "1.2.3-rc.1"}, "", "1\n", exitOK},
+		{"compare lax", []string{"compare", "-lax", "1.2", "1.2.0"}, "", "0\n", exitOK},
+		{"compare json", []string{"compare", "-json", "2.0.0", "1.0.0"}, "", "1\n", exitOK},
+		{"compare invalid", []string{"compare", "1.2", "1.2.0"}, "", "", exitError},
+		{"compare one argument", []string{"compare", "1.2.3"}, "", "", exitError},
+
+		{"sort", []string{"sort", "1.10.0", "v1.9.0", "1.9.0-rc.1"}, "", "1.9.0-rc.1\nv1.9.0\n1.10.0\n", exitOK},
+		{"sort stdin", []string{"sort"}, "v1.10.0\n v1.2.0\tv1.9.0\n\n", "v1.2.0\nv1.9.0\nv1.10.0\n", exitOK},
+		{"sort reverse", []string{"sort", "-reverse", "1.0.0", "2.0.0", "1.5.0"}, "", "2.0.0\n1.5.0\n1.0.0\n", exitOK},
+		{"sort unique", []string{"sort", "--unique", "v1.0.0", "2.0.0", "1.0.0+b", "1.0.0"}, "", "v1.0.0\n2.0.0\n", exitOK},
+		{"sort reverse stable", []string{"sort", "-reverse", "1.0.0+a", "2.0.0", "1.0.0+b", "1.0.0"}, "", "2.0.0\n1.0.0+a\n1.0.0+b\n1.0.0\n", exitOK},
+		{"sort reverse unique", []string{"sort", "-reverse", "-unique", "1.0.0", "1.0.0+b", "0.1.0"}, "", "1.0.0\n0.1.0\n", exitOK},
+		{"sort lax", []string{"sort", "-lax", "2", "1.5", "v1"}, "", "v1\n1.5\n2\n", exitOK},
+		{"sort json", []string{"sort", "-json", "2.0.0", "1.0.0"}, "", `["1.0.0","2.0.0"]` + "\n", exitOK},
+		{"sort json empty", []string{"sort", "-json"}, "", "[]\n", exitOK},
+		{"sort invalid", []string{"sort", "1.0.0", "x"}, "", "", exitError},
+
+		{"max", []string{"max", "1.2.3", "v1.10.0", "1.9.0"}, "", "v1.10.0\n", exitOK},
+		{"max stdin", []string{"max"}, "1.0.0\n2.0.0-rc.1\n", "2.0.0-rc.1\n", exitOK},
+		{"max json", []string{"max", "-json", "1.0.0", "2.0.0"}, "", `"2.0.0"` + "\n", exitOK},
+		{"max empty", []string{"max"}, "", "", exitError},
+
+		{"bump major", []string{"bump", "major", "1.2.3"}, "", "2.0.0\n", exitOK},
+		{"bump minor", []string{"bump", "minor", "v1.2.3-rc.1"}, "", "v1.3.0\n", exitOK},
+		{"bump patch", []string{"bump", "patch", "1.2.3+build"}, "", "1.2.4\n", exitOK},
+		{"bump pre", []string{"bump", "pre", "1.2.3"}, "", "1.2.4-0\n", exitOK},
+		{"bump pre preid", []string{"bump", "-preid", "rc", "pre", "1.2.3-rc.4"}, "", "1.2.3-rc.5\n", exitOK},
+		{"bump lax", []string{"bump", "-lax", "minor", "v1.2"}, "", "v1.3\n", exitOK},
+		{"bump json", []string{"bump", "-json", "major", "v1.2.3"}, "", `"v2.0.0"` + "\n", exitOK},
+		{"bump unknown part", []string{"bump", "build", "1.2.3"}, "", "", exitError},
+		{"bump overflow", []string{"bump", "patch", "1.2.18446744073709551615"}, "", "", exitError},
+		{"bump major overflow", []string{"bump", "major", "18446744073709551615.0.0"}, "", "", exitError},
+		{"bump major pre-release max", []string{"bump", "major", "18446744073709551615.0.0-rc.1"}, "", "18446744073709551615.0.0\n", exitOK},
+		{"bump invalid preid", []string{"bump", "-preid", "r.c", "pre", "1.2.3"}, "", "", exitError},
+		{"bump missing version", []string{"bump", "major"}, "", "", exitError},
+
+		{"satisfies", []string{"satisfies", "^1.2", "1.1.0", "1.2.5", "v1.9.0", "2.0.0"}, "", "1.2.5\nv1.9.0\n", exitOK},
+		{"satisfies none", []string{"satisfies", "^1.2", "2.0.0"}, "", "", exitFalse},
+		{"satisfies stdin", []string{"satisfies", ">=1.0.0 <2.0.0 || 3.x"}, "0.9.0 1.0.0 3.1.0", "1.0.0\n3.1.0\n", exitOK},
+		{"satisfies cargo", []string{"satisfies", "-cargo", "1.2", "1.9.0", "2.0.0"}, "", "1.9.0\n", exitOK},
+		{"satisfies json", []string{"satisfies", "-json", "~1.2", "1.2.9", "1.3.0"}, "", `["1.2.9"]` + "\n", exitOK},
+		{"satisfies json none", []string{"satisfies", "-json", "~1.2", "1.3.0"}, "", "[]\n", exitFalse},
+		{"satisfies invalid range", []string{"satisfies", ">>1", "1.0.0"}, "", "", exitError},
+		{"satisfies no range", []string{"satisfies"}, "", "", exitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.status {
+				t.Errorf("run(%q) = %d, want %d; stderr: %s", tt.args, status, tt.status, stderr.String())
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote %q, want %q", tt.args, got, tt.want)
+			}
+
+			if status == exitError && stderr.Len() == 0 {
+				t.Errorf("run(%q) failed without writing to stderr", tt.args)
+			}
+		})
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	t.Parallel()
+
+	for _, arg := range []string{"help", "-h", "--help"} {
+		var stdout, stderr bytes.Buffer
+
+		if status := run([]string{arg}, strings.NewReader(""), &stdout, &stderr); status != exitOK {
+			t.Errorf("run(%q) = %d, want %d", arg, status, exitOK)
+		}
+
+		for _, c := range commands() {
+			if !strings.Contains(stdout.String(), c.name) {
+				t.Errorf("run(%q) wrote %q, want the usage of %q", arg, stdout.String(), c.name)
+			}
+		}
+	}
+}
+
+func TestRunReadError(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	status := run([]string{"sort"}, iotest.ErrReader(iotest.ErrTimeout), &stdout, &stderr)
+	if status != exitError {
+		t.Errorf("run() = %d, want %d", status, exitError)
+	}
+
+	if !strings.Contains(stderr.String(), iotest.ErrTimeout.Error()) {
+		t.Errorf("run() wrote %q to stderr, want the read error", stderr.String())
+	}
+}
@@ -0,0 +1,580 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Values for the constraint dialects.
+const (
+	npm dialect = iota
+	cargo
+)
+
+// Values for the comparison operators in constraints.
+const (
+	opEqual operator = iota
+	opGreater
+	opGreaterEqual
+	opLess
+	opLessEqual
+)
+
+// ErrInvalidConstraint is the error returned by the constraint parsing
+// functions when they encounter invalid constraint string.
+var ErrInvalidConstraint = errors.New("invalid version constraint")
+
+// A Constraint is a parsed version range that versions can be checked against
+// using [Version.Satisfies]. A Constraint is a union of comparator sets and
+// a version satisfies the Constraint if it satisfies every comparator in at
+// least one of the sets.
+type Constraint struct {
+	sets    [][]comparator
+	dialect dialect
+}
+
+// A comparator is a single primitive comparison against a version. All of
+// the range syntaxes are reduced to comparators when the constraint is parsed.
+type comparator struct {
+	op  operator
+	ver *Version
+}
+
+// A dialect is the range syntax that a Constraint was parsed from.
+type dialect int
+
+// An operator is the comparison operator of a comparator.
+type operator int
+
+// A partialVersion is a version that may have missing or wildcard core version
+// numbers, such as "1.2" or "1.x".
+type partialVersion struct {
+	ver *Version
+
+	// n is the number of core version numbers that were given in the version
+	// string before the first missing or wildcard number.
+	n int
+}
+
+// MustParseConstraint parses the given string into a Constraint and panics if
+// it encounters an error.
+func MustParseConstraint(s string) *Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a constraint: %v", s, err))
+	}
+
+	return c
+}
+
+// ParseConstraint parses the given string into a Constraint. The string uses
+// the range syntax of npm (node-semver). It supports the primitive operators
+// "=", "<", "<=", ">", and ">=", the X-ranges like "1.2.x" and "*", partial
+// versions like "1.2", the tilde ranges like "~1.2.3", the caret ranges like
+// "^1.2.3", the hyphen ranges like "1.2 - 1.4", and the unions of ranges
+// separated by "||". Comparators separated by whitespace must all be satisfied.
+//
+// As in npm, a version with pre-release identifiers satisfies the constraint
+// only if some comparator in the same set has a version with the same core
+// version and pre-release identifiers.
+func ParseConstraint(s string) (*Constraint, error) {
+	parts := strings.Split(s, "||")
+	sets := make([][]comparator, 0, len(parts))
+
+	for _, part := range parts {
+		set, err := parseNpmSet(part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse constraint %q: %w", s, err)
+		}
+
+		sets = append(sets, set)
+	}
+
+	return &Constraint{sets: sets, dialect: npm}, nil
+}
+
+// Satisfies reports whether v satisfies the constraint c. Versions parsed with
+// [ParseBig] are compared by the full values of their core version numbers, so
+// a pre-release version with numbers that do not fit in uint64 satisfies c only
+// under the same rules as any other pre-release version.
+func (v *Version) Satisfies(c *Constraint) bool {
+	return c.check(v)
+}
+
+// String returns the normalized string representation of c in the syntax of
+// the dialect it was parsed from. The ranges are written out as primitive
+// comparators, so for example "^1.2.3" is returned as ">=1.2.3 <2.0.0-0".
+func (c *Constraint) String() string {
+	var sb strings.Builder
+
+	sep := " "
+	if c.dialect == cargo {
+		sep = ", "
+	}
+
+	for i, set := range c.sets {
+		if i > 0 {
+			sb.WriteString(" || ")
+		}
+
+		for j, cmp := range set {
+			if j > 0 {
+				sb.WriteString(sep)
+			}
+
+			sb.WriteString(cmp.String())
+		}
+	}
+
+	return sb.String()
+}
+
+// String returns the string representation of the comparator.
+func (c comparator) String() string {
+	return c.op.String() + c.ver.String()
+}
+
+// String returns the string representation of the operator.
+func (o operator) String() string {
+	switch o {
+	case opEqual:
+		return "="
+	case opGreater:
+		return ">"
+	case opGreaterEqual:
+		return ">="
+	case opLess:
+		return "<"
+	case opLessEqual:
+		return "<="
+	default:
+		// Internal invariant violation.
+		panic(fmt.Sprintf("invalid constraint operator: %d", int(o)))
+	}
+}
+
+// check reports whether v satisfies the constraint c.
+func (c *Constraint) check(v *Version) bool {
+	for _, set := range c.sets {
+		if checkSet(set, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match reports whether v satisfies the comparator.
+func (c comparator) match(v *Version) bool {
+	d := v.Compare(c.ver)
+
+	switch c.op {
+	case opEqual:
+		return d == 0
+	case opGreater:
+		return d > 0
+	case opGreaterEqual:
+		return d >= 0
+	case opLess:
+		return d < 0
+	case opLessEqual:
+		return d <= 0
+	default:
+		// Internal invariant violation.
+		panic(fmt.Sprintf("invalid constraint operator: %d", int(c.op)))
+	}
+}
+
+// checkSet reports whether v satisfies every comparator in set. A version with
+// pre-release identifiers satisfies the set only if one of the comparators has
+// a pre-release version with the same core version.
+func checkSet(set []comparator, v *Version) bool {
+	for _, c := range set {
+		if !c.match(v) {
+			return false
+		}
+	}
+
+	if len(v.Prerelease) == 0 {
+		return true
+	}
+
+	for _, c := range set {
+		if len(c.ver.Prerelease) == 0 {
+			continue
+		}
+
+		if c.ver.compareBigCore(v) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseNpmSet parses a single set of comparators separated by whitespace.
+func parseNpmSet(s string) ([]comparator, error) {
+	fields := strings.Fields(s)
+
+	if len(fields) == 0 {
+		return []comparator{anyComparator()}, nil
+	}
+
+	// Hyphen ranges must have whitespace around the hyphen, so they can be
+	// detected from the fields.
+	if len(fields) == 3 && fields[1] == "-" { //nolint:mnd // <from> - <to>
+		return parseHyphenRange(fields[0], fields[2])
+	}
+
+	var set []comparator
+
+	for i := 0; i < len(fields); i++ {
+		tok := fields[i]
+
+		// Allow whitespace between the operator and the version, for example
+		// ">= 1.2.3".
+		if strings.Trim(tok, "<>=~^") == "" && i+1 < len(fields) {
+			i++
+			tok += fields[i]
+		}
+
+		cmps, err := parseNpmComparator(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		set = append(set, cmps...)
+	}
+
+	return set, nil
+}
+
+// parseNpmComparator parses a single range token and reduces it to primitive
+// comparators.
+func parseNpmComparator(s string) ([]comparator, error) {
+	op, rest := cutOperator(s)
+
+	p, err := parsePartial(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "", "=":
+		return xRange(p), nil
+	case "~", "~>":
+		return tildeRange(p), nil
+	case "^":
+		return caretRange(p), nil
+	case ">":
+		return greaterRange(p), nil
+	case ">=":
+		return []comparator{{opGreaterEqual, p.ver}}, nil
+	case "<":
+		return lessRange(p), nil
+	case "<=":
+		return lessEqualRange(p), nil
+	default:
+		return nil, fmt.Errorf("%w: invalid operator %q in %q", ErrInvalidConstraint, op, s)
+	}
+}
+
+// parseHyphenRange reduces the hyphen range "from - to" to comparators.
+func parseHyphenRange(from, to string) ([]comparator, error) {
+	lo, err := parsePartial(from)
+	if err != nil {
+		return nil, err
+	}
+
+	hi, err := parsePartial(to)
+	if err != nil {
+		return nil, err
+	}
+
+	set := []comparator{{opGreaterEqual, lo.ver}}
+
+	if hi.n == 3 { //nolint:mnd // full version
+		return append(set, comparator{opLessEqual, hi.ver}), nil
+	}
+
+	if u := hi.next(); u != nil {
+		set = append(set, comparator{opLess, u})
+	}
+
+	return set, nil
+}
+
+// xRange reduces a partial version without an operator or with "=" to
+// comparators. For example, "1.2" or "1.2.x" matches all of the 1.2 versions.
+func xRange(p partialVersion) []comparator {
+	if p.n == 0 {
+		return []comparator{anyComparator()}
+	}
+
+	if p.n == 3 { //nolint:mnd // full version
+		return []comparator{{opEqual, p.ver}}
+	}
+
+	return p.span()
+}
+
+// tildeRange reduces a tilde range to comparators. A tilde range allows patch
+// level changes if the minor version is given and minor level changes if it is
+// not.
+func tildeRange(p partialVersion) []comparator {
+	switch p.n {
+	case 0:
+		return []comparator{anyComparator()}
+	case 1:
+		return p.span()
+	default:
+		return bounded(p.ver, minorUpper(p.ver))
+	}
+}
+
+// caretRange reduces a caret range to comparators. A caret range allows
+// changes that do not modify the left-most non-zero number of the core version.
+func caretRange(p partialVersion) []comparator {
+	v := p.ver
+
+	switch {
+	case p.n == 0:
+		return []comparator{anyComparator()}
+	case p.n == 1 || v.Major > 0:
+		return bounded(v, majorUpper(v))
+	case p.n == 2 || v.Minor > 0:
+		return bounded(v, minorUpper(v))
+	default:
+		return bounded(v, patchUpper(v))
+	}
+}
+
+// greaterRange reduces a range with the operator ">" to comparators.
+func greaterRange(p partialVersion) []comparator {
+	if p.n == 0 {
+		return []comparator{noneComparator()}
+	}
+
+	if p.n == 3 { //nolint:mnd // full version
+		return []comparator{{opGreater, p.ver}}
+	}
+
+	u := p.next()
+	if u == nil {
+		return []comparator{noneComparator()}
+	}
+
+	// The pre-releases of the next version are excluded like in npm.
+	return []comparator{{opGreaterEqual, &Version{Major: u.Major, Minor: u.Minor, Patch: u.Patch}}}
+}
+
+// lessRange reduces a range with the operator "<" to comparators.
+func lessRange(p partialVersion) []comparator {
+	if p.n == 0 {
+		return []comparator{noneComparator()}
+	}
+
+	if p.n == 3 { //nolint:mnd // full version
+		return []comparator{{opLess, p.ver}}
+	}
+
+	return []comparator{{opLess, withZeroPrerelease(p.ver)}}
+}
+
+// lessEqualRange reduces a range with the operator "<=" to comparators.
+func lessEqualRange(p partialVersion) []comparator {
+	if p.n == 0 {
+		return []comparator{anyComparator()}
+	}
+
+	if p.n == 3 { //nolint:mnd // full version
+		return []comparator{{opLessEqual, p.ver}}
+	}
+
+	u := p.next()
+	if u == nil {
+		return []comparator{anyComparator()}
+	}
+
+	return []comparator{{opLess, u}}
+}
+
+// anyComparator returns a comparator that matches every version without
+// pre-release identifiers.
+func anyComparator() comparator {
+	return comparator{opGreaterEqual, &Version{}}
+}
+
+// noneComparator returns a comparator that matches no versions.
+func noneComparator() comparator {
+	return comparator{opLess, withZeroPrerelease(&Version{})}
+}
+
+// bounded returns the comparators for the range from lo, inclusive, to hi,
+// exclusive. If hi is nil, the range has no upper bound.
+func bounded(lo, hi *Version) []comparator {
+	if hi == nil {
+		return []comparator{{opGreaterEqual, lo}}
+	}
+
+	return []comparator{{opGreaterEqual, lo}, {opLess, hi}}
+}
+
+// span returns the comparators that match every version that has the given
+// core version numbers of p.
+func (p partialVersion) span() []comparator {
+	return bounded(p.ver, p.next())
+}
+
+// next returns the lowest version that is higher than every version that
+// matches the given core version numbers of p. The returned version has
+// the pre-release "0" so that the pre-releases of it are excluded too. next
+// returns nil if the version number would overflow.
+func (p partialVersion) next() *Version {
+	switch p.n {
+	case 1:
+		return majorUpper(p.ver)
+	case 2: //nolint:mnd // major and minor given
+		return minorUpper(p.ver)
+	default:
+		return patchUpper(p.ver)
+	}
+}
+
+// cutOperator splits the range operator from the start of s.
+func cutOperator(s string) (string, string) {
+	i := 0
+	for i < len(s) && strings.IndexByte("<>=~^", s[i]) >= 0 {
+		i++
+	}
+
+	return s[:i], s[i:]
+}
+
+// parsePartial parses a possibly partial version used in a constraint. The core
+// version numbers may be missing or wildcards ("x", "X", or "*"). After
+// the first wildcard, all of the following core version numbers must also be
+// wildcards and the version may not have pre-release identifiers or build
+// metadata.
+func parsePartial(s string) (partialVersion, error) {
+	if s == "" {
+		return partialVersion{ver: &Version{}, n: 0}, nil
+	}
+
+	end := strings.IndexAny(s, "-+")
+	if end < 0 {
+		end = len(s)
+	}
+
+	core := strings.Split(strings.TrimPrefix(s[:end], "v"), ".")
+	n := len(core)
+
+	for i, c := range core {
+		if !isWildcard(c) {
+			continue
+		}
+
+		n = i
+
+		for _, d := range core[i+1:] {
+			if !isWildcard(d) {
+				return partialVersion{}, fmt.Errorf(
+					"%w: version number after a wildcard in %q",
+					ErrInvalidConstraint,
+					s,
+				)
+			}
+		}
+
+		if end < len(s) {
+			return partialVersion{}, fmt.Errorf(
+				"%w: wildcard version %q may not have pre-release or build metadata",
+				ErrInvalidConstraint,
+				s,
+			)
+		}
+
+		break
+	}
+
+	if n == 0 {
+		return partialVersion{ver: &Version{}, n: 0}, nil
+	}
+
+	if n < len(core) {
+		s = strings.Join(core[:n], ".")
+	}
+
+	v, err := ParseLax(s)
+	if err != nil {
+		return partialVersion{}, fmt.Errorf("%w: %w", ErrInvalidConstraint, err)
+	}
+
+	return partialVersion{ver: v, n: n}, nil
+}
+
+// majorUpper returns the lowest pre-release of the next major version after v,
+// or nil if the major version cannot be incremented.
+func majorUpper(v *Version) *Version {
+	if v.Major == math.MaxUint64 {
+		return nil
+	}
+
+	return withZeroPrerelease(&Version{Major: v.Major + 1})
+}
+
+// minorUpper returns the lowest pre-release of the next minor version after v,
+// or nil if the minor version cannot be incremented.
+func minorUpper(v *Version) *Version {
+	if v.Minor == math.MaxUint64 {
+		return majorUpper(v)
+	}
+
+	return withZeroPrerelease(&Version{Major: v.Major, Minor: v.Minor + 1})
+}
+
+// patchUpper returns the lowest pre-release of the next patch version after v,
+// or nil if the patch version cannot be incremented.
+func patchUpper(v *Version) *Version {
+	if v.Patch == math.MaxUint64 {
+		return minorUpper(v)
+	}
+
+	return withZeroPrerelease(&Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1})
+}
+
+// withZeroPrerelease returns a copy of the core version of v with
+// the pre-release "0", the lowest possible pre-release of the version.
+func withZeroPrerelease(v *Version) *Version {
+	return &Version{
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Prerelease: Prerelease{NumericIdentifier(0)},
+	}
+}
+
+func isWildcard(s string) bool {
+	return s == "x" || s == "X" || s == "*"
+}
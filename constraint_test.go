@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c       string
+		want    string
+		wantErr bool
+	}{
+		{"", ">=0.0.0", false},
+		{"*", ">=0.0.0", false},
+		{"x", ">=0.0.0", false},
+		{"1.2.3", "=1.2.3", false},
+		{"=v1.2.3", "=1.2.3", false},
+		{"1", ">=1.0.0 <2.0.0-0", false},
+		{"1.x", ">=1.0.0 <2.0.0-0", false},
+		{"1.2.x", ">=1.2.0 <1.3.0-0", false},
+		{"1.2.*", ">=1.2.0 <1.3.0-0", false},
+		{"~1.2.3", ">=1.2.3 <1.3.0-0", false},
+		{"~1.2", ">=1.2.0 <1.3.0-0", false},
+		{"~1", ">=1.0.0 <2.0.0-0", false},
+		{"~>1.2.3", ">=1.2.3 <1.3.0-0", false},
+		{"~1.2.3-beta.2", ">=1.2.3-beta.2 <1.3.0-0", false},
+		{"^1.2.3", ">=1.2.3 <2.0.0-0", false},
+		{"^0.2.3", ">=0.2.3 <0.3.0-0", false},
+		{"^0.0.3", ">=0.0.3 <0.0.4-0", false},
+		{"^1.2.x", ">=1.2.0 <2.0.0-0", false},
+		{"^0.0.x", ">=0.0.0 <0.1.0-0", false},
+		{"^0.0", ">=0.0.0 <0.1.0-0", false},
+		{"^1.x", ">=1.0.0 <2.0.0-0", false},
+		{"^0.x", ">=0.0.0 <1.0.0-0", false},
+		{">1", ">=2.0.0", false},
+		{">1.2", ">=1.3.0", false},
+		{">1.2.3", ">1.2.3", false},
+		{"<1.2", "<1.2.0-0", false},
+		{"<=1.2", "<1.3.0-0", false},
+		{">= 1.2.3 < 2", ">=1.2.3 <2.0.0-0", false},
+		{"1.2.3 - 2.3.4", ">=1.2.3 <=2.3.4", false},
+		{"1.2 - 2.3.4", ">=1.2.0 <=2.3.4", false},
+		{"1.2.3 - 2.3", ">=1.2.3 <2.4.0-0", false},
+		{"1.2.3 - 2", ">=1.2.3 <3.0.0-0", false},
+		{"^1.2 || >=3", ">=1.2.0 <2.0.0-0 || >=3.0.0", false},
+		{"1.x || ", ">=1.0.0 <2.0.0-0 || >=0.0.0", false},
+		{"1.x.3", "", true},
+		{"1.x-beta", "", true},
+		{"01.2.3", "", true},
+		{"!1.2.3", "", true},
+		{"=>1.2.3", "", true},
+		{"1.2.3.4", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseConstraint(tt.c)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseConstraint(%q) = %v, want error", tt.c, got)
+				}
+
+				if !errors.Is(err, semver.ErrInvalidConstraint) {
+					t.Errorf("ParseConstraint(%q) error = %v, want ErrInvalidConstraint", tt.c, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseConstraint(%q) failed unexpectedly: %v", tt.c, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseConstraint(%q).String() = %q, want %q", tt.c, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionSatisfies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"*", "1.2.3", true},
+		{"*", "1.2.3-beta", false},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.3+build", true},
+		{"1.2.3", "1.2.4", false},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "2.0.0-alpha", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^1.2.3-beta.2", "1.2.3-beta.4", true},
+		{"^1.2.3-beta.2", "1.2.3-beta.1", false},
+		{"^1.2.3-beta.2", "1.2.4-beta.2", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1", "1.9.0", true},
+		{"1.2.x", "1.2.0", true},
+		{"1.2.x", "1.3.0", false},
+		{">=1.0.0 <2.0.0", "1.5.0", true},
+		{">=1.0.0 <2.0.0", "2.0.0", false},
+		{">=1.0.0 <2.0.0", "0.9.0", false},
+		{"1.2 - 1.4", "1.4.7", true},
+		{"1.2 - 1.4", "1.5.0", false},
+		{"1.2 - 1.4", "1.1.9", false},
+		{"1.2.3 - 2.3.4", "2.3.4", true},
+		{"<1.2", "1.2.0-beta", false},
+		{"<1.2", "1.1.9", true},
+		{">1", "2.0.0", true},
+		{">1", "1.9.9", false},
+		{"<=1.2", "1.2.9", true},
+		{"^1.0.0 || ^3.0.0", "3.1.0", true},
+		{"^1.0.0 || ^3.0.0", "2.1.0", false},
+		{">1.0.0-alpha <1.0.0", "1.0.0-beta", true},
+		{">1.0.0-alpha <1.0.0 || >=2", "1.0.0-beta", true},
+		{"<2 || >=3-beta", "3.0.0-beta.2", true},
+		{"<2 || >=3-beta", "2.0.0-beta.2", false},
+		{">*", "0.0.0", false},
+		{"<x", "0.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			c := semver.MustParseConstraint(tt.c)
+			v := semver.MustParse(tt.v)
+
+			if got := v.Satisfies(c); got != tt.want {
+				t.Errorf("Version{%q}.Satisfies(%q) = %v, want %v", tt.v, tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionSatisfiesBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"*", "99999999999999999999.0.0", true},
+		{">=1.0.0", "99999999999999999999.0.0", true},
+		{"<1.0.0", "99999999999999999999.0.0", false},
+		{"^1.2.3", "1.99999999999999999999.0", true},
+		{"^1.2.3", "99999999999999999999.0.0", false},
+		{"~1.2.3", "1.2.99999999999999999999", true},
+		{">=1.0.0", "99999999999999999999.0.0-rc.1", false},
+		{">=1.2.3-rc.1", "1.2.99999999999999999999-rc.1", false},
+		{"^1.2.3-rc.1", "1.99999999999999999999.0-rc.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			c := semver.MustParseConstraint(tt.c)
+			v := semver.MustParseBig(tt.v)
+
+			if got := v.Satisfies(c); got != tt.want {
+				t.Errorf("Version{%q}.Satisfies(%q) = %v, want %v", tt.v, tt.c, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+/*
+Package conventional parses commit messages that follow the Conventional
+Commits specification and determines the next semantic version from them.
+
+The specification can be found at
+https://www.conventionalcommits.org/en/v1.0.0/. A commit message starts with
+a header that has the type of the commit, an optional scope, and
+a description, like "feat(parser): add support for big numbers". The header may
+be followed by a body and footers like "Refs: #123". A "!" before the colon in
+the header or a "BREAKING CHANGE" footer marks that the commit introduces
+a breaking change.
+
+# Parsing commit messages
+
+[Parse] parses a commit message into a [Commit]:
+
+	c, err := conventional.Parse("feat(api)!: remove the v1 endpoints")
+
+# Determining the next version
+
+[Next] returns the next version for a list of commit messages. A breaking
+change increments the major version, a commit of the type "feat" the minor
+version, and the other commits the patch version, except for the types that do
+not cause a release, like "docs" and "chore". While the major version is zero,
+breaking changes increment the minor version instead. The messages that do not
+follow the specification are ignored.
+
+	v := semver.MustParse("1.4.2")
+	next, ok, err := conventional.Next(v, []string{"fix: handle empty input", "feat: add the max command"})
+	// next is 1.5.0 and ok is true
+
+The types that do not cause a release can be changed using an [Analyzer].
+*/
+package conventional
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Footer tokens that mark a breaking change. They are case-sensitive.
+const (
+	breakingChange       = "BREAKING CHANGE"
+	breakingChangeHyphen = "BREAKING-CHANGE"
+)
+
+// ErrInvalidCommit is returned when a commit message does not follow
+// the Conventional Commits specification.
+var ErrInvalidCommit = errors.New("invalid conventional commit")
+
+// A Commit is a commit message that follows the Conventional Commits
+// specification.
+type Commit struct {
+	// Type is the type of the commit, like "feat" or "fix", in lower case.
+	Type string
+
+	// Scope is the scope of the commit given in parentheses after the type,
+	// or an empty string if the commit has no scope.
+	Scope string
+
+	// Description is the description of the commit after the colon in
+	// the header.
+	Description string
+
+	// Body is the body of the commit message between the header and
+	// the footers.
+	Body string
+
+	// Footers are the footers at the end of the commit message.
+	Footers []Footer
+
+	// Breaking reports whether the commit introduces a breaking change,
+	// either by a "!" before the colon in the header or by a "BREAKING CHANGE"
+	// or "BREAKING-CHANGE" footer.
+	Breaking bool
+}
+
+// A Footer is a footer of a commit message, like "Refs: #123" or
+// "BREAKING CHANGE: the config file is no longer read".
+type Footer struct {
+	// Token is the token of the footer, like "Refs" or "BREAKING CHANGE".
+	Token string
+
+	// Value is the value of the footer. It may span several lines.
+	Value string
+}
+
+// Parse parses a commit message that follows the Conventional Commits
+// specification. It returns an error that wraps [ErrInvalidCommit] if
+// the header of the message is not valid.
+func Parse(msg string) (*Commit, error) {
+	msg = strings.ReplaceAll(msg, "\r\n", "\n")
+	header, rest, _ := strings.Cut(msg, "\n")
+
+	c, err := parseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Body, c.Footers = parseBody(rest)
+
+	if !c.Breaking {
+		c.Breaking = slices.ContainsFunc(c.Footers, func(f Footer) bool {
+			return f.Token == breakingChange || f.Token == breakingChangeHyphen
+		})
+	}
+
+	return c, nil
+}
+
+// parseHeader parses the header of a commit message, in the form
+// "<type>[(<scope>)][!]: <description>".
+func parseHeader(s string) (*Commit, error) {
+	i := 0
+	for i < len(s) && isTokenChar(s[i]) {
+		i++
+	}
+
+	if i == 0 {
+		return nil, fmt.Errorf("%w: missing type in %q", ErrInvalidCommit, s)
+	}
+
+	c := &Commit{Type: strings.ToLower(s[:i])} //nolint:exhaustruct // the rest are parsed below
+	rest := s[i:]
+
+	if scope, ok := strings.CutPrefix(rest, "("); ok {
+		end := strings.IndexByte(scope, ')')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unterminated scope in %q", ErrInvalidCommit, s)
+		}
+
+		c.Scope = strings.TrimSpace(scope[:end])
+		if c.Scope == "" {
+			return nil, fmt.Errorf("%w: empty scope in %q", ErrInvalidCommit, s)
+		}
+
+		rest = scope[end+1:]
+	}
+
+	if after, ok := strings.CutPrefix(rest, "!"); ok {
+		c.Breaking = true
+		rest = after
+	}
+
+	desc, ok := strings.CutPrefix(rest, ": ")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing \": \" after the type in %q", ErrInvalidCommit, s)
+	}
+
+	c.Description = strings.TrimSpace(desc)
+	if c.Description == "" {
+		return nil, fmt.Errorf("%w: missing description in %q", ErrInvalidCommit, s)
+	}
+
+	return c, nil
+}
+
+// parseBody parses the part of a commit message after the header into
+// the body and the footers. The footers start from the first paragraph that
+// starts with a footer, and the lines that do not start a new footer continue
+// the value of the previous one.
+func parseBody(s string) (string, []Footer) {
+	s = strings.Trim(s, "\n")
+	if s == "" {
+		return "", nil
+	}
+
+	lines := strings.Split(s, "\n")
+	start := len(lines)
+
+	for i, line := range lines {
+		if (i == 0 || strings.TrimSpace(lines[i-1]) == "") && isFooter(line) {
+			start = i
+
+			break
+		}
+	}
+
+	var footers []Footer
+
+	for _, line := range lines[start:] {
+		if token, value, ok := cutFooter(line); ok {
+			footers = append(footers, Footer{Token: token, Value: value})
+		} else {
+			last := &footers[len(footers)-1]
+			last.Value += "\n" + line
+		}
+	}
+
+	for i := range footers {
+		footers[i].Value = strings.TrimRightFunc(footers[i].Value, isSpace)
+	}
+
+	return strings.TrimSpace(strings.Join(lines[:start], "\n")), footers
+}
+
+// cutFooter splits a line that starts a footer, in the form "<token>: <value>"
+// or "<token> #<value>", into the token and the value.
+func cutFooter(line string) (string, string, bool) {
+	if value, ok := strings.CutPrefix(line, breakingChange+": "); ok {
+		return breakingChange, value, true
+	}
+
+	i := 0
+	for i < len(line) && isTokenChar(line[i]) {
+		i++
+	}
+
+	if i == 0 {
+		return "", "", false
+	}
+
+	if value, ok := strings.CutPrefix(line[i:], ": "); ok {
+		return line[:i], value, true
+	}
+
+	if value, ok := strings.CutPrefix(line[i:], " #"); ok {
+		return line[:i], value, true
+	}
+
+	return "", "", false
+}
+
+// isFooter reports whether the line starts a footer.
+func isFooter(line string) bool {
+	_, _, ok := cutFooter(line)
+
+	return ok
+}
+
+// isTokenChar reports whether c may appear in a commit type or a footer
+// token.
+func isTokenChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_'
+}
+
+// isSpace reports whether r is white space.
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
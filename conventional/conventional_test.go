@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conventional_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver/conventional"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		msg  string
+		want *conventional.Commit
+	}{
+		{
+			"header",
+			"fix: handle empty input",
+			&conventional.Commit{Type: "fix", Description: "handle empty input"},
+		},
+		{
+			"scope",
+			"feat(parser): add big numbers",
+			&conventional.Commit{Type: "feat", Scope: "parser", Description: "add big numbers"},
+		},
+		{
+			"breaking header",
+			"feat(api)!: remove the v1 endpoints",
+			&conventional.Commit{Type: "feat", Scope: "api", Description: "remove the v1 endpoints", Breaking: true},
+		},
+		{
+			"breaking header without scope",
+			"refactor!: drop Go 1.22",
+			&conventional.Commit{Type: "refactor", Description: "drop Go 1.22", Breaking: true},
+		},
+		{
+			"type case",
+			"FEAT: shout",
+			&conventional.Commit{Type: "feat", Description: "shout"},
+		},
+		{
+			"body",
+			"fix: handle empty input\n\nThe parser panicked on empty input.\n\nIt now returns an error.\n",
+			&conventional.Commit{
+				Type:        "fix",
+				Description: "handle empty input",
+				Body:        "The parser panicked on empty input.\n\nIt now returns an error.",
+			},
+		},
+		{
+			"footers",
+			"fix: typo\n\nRefs: #123\nReviewed-by: Z\nCloses #7",
+			&conventional.Commit{
+				Type:        "fix",
+				Description: "typo",
+				Footers: []conventional.Footer{
+					{Token: "Refs", Value: "#123"},
+					{Token: "Reviewed-by", Value: "Z"},
+					{Token: "Closes", Value: "7"},
+				},
+			},
+		},
+		{
+			"body and footers",
+			"feat: allow config\r\n\r\nSee the docs: they are\r\nlong.\r\n\r\nBREAKING CHANGE: the `extends` key\r\nis now ignored\r\nRefs: #1\r\n",
+			&conventional.Commit{
+				Type:        "feat",
+				Description: "allow config",
+				Body:        "See the docs: they are\nlong.",
+				Footers: []conventional.Footer{
+					{Token: "BREAKING CHANGE", Value: "the `extends` key\nis now ignored"},
+					{Token: "Refs", Value: "#1"},
+				},
+				Breaking: true,
+			},
+		},
+		{
+			"breaking hyphen footer",
+			"chore: bump deps\n\nBREAKING-CHANGE: requires Go 1.24",
+			&conventional.Commit{
+				Type:        "chore",
+				Description: "bump deps",
+				Footers:     []conventional.Footer{{Token: "BREAKING-CHANGE", Value: "requires Go 1.24"}},
+				Breaking:    true,
+			},
+		},
+		{
+			"lower-case breaking footer",
+			"fix: x\n\nbreaking-change: no",
+			&conventional.Commit{
+				Type:        "fix",
+				Description: "x",
+				Footers:     []conventional.Footer{{Token: "breaking-change", Value: "no"}},
+			},
+		},
+		{
+			"footer value with blank line",
+			"fix: x\n\nBREAKING CHANGE: first\n\nsecond\nRefs: #2",
+			&conventional.Commit{
+				Type:        "fix",
+				Description: "x",
+				Footers: []conventional.Footer{
+					{Token: "BREAKING CHANGE", Value: "first\n\nsecond"},
+					{Token: "Refs", Value: "#2"},
+				},
+				Breaking: true,
+			},
+		},
+		{
+			"footer-like line in body",
+			"fix: x\n\nsome text\nNote: not a footer",
+			&conventional.Commit{Type: "fix", Description: "x", Body: "some text\nNote: not a footer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := conventional.Parse(tt.msg)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.msg, err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.msg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"",
+		"Merge branch 'main'",
+		"fix handle empty input",
+		"fix:handle empty input",
+		"fix: ",
+		": no type",
+		"feat(: unterminated",
+		"feat(): empty scope",
+		"feat(a)x: junk",
+		"feat!!: twice",
+		"\nfix: header on second line",
+	}
+
+	for _, msg := range tests {
+		t.Run(msg, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := conventional.Parse(msg)
+			if !errors.Is(err, conventional.ErrInvalidCommit) {
+				t.Errorf("Parse(%q) = %+v, %v, want error %v", msg, c, err, conventional.ErrInvalidCommit)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conventional
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// Values for the version increment, in the order of their precedence.
+const (
+	// None means that the commits do not cause a release.
+	None Bump = iota
+
+	// Patch means that the commits increment the patch version.
+	Patch
+
+	// Minor means that the commits increment the minor version.
+	Minor
+
+	// Major means that the commits increment the major version.
+	Major
+)
+
+// A Bump is the kind of version increment that commits require.
+type Bump int
+
+// An Analyzer determines the next version from commit messages using
+// configurable options. The zero Analyzer determines the next version like
+// [Next].
+type Analyzer struct {
+	// NoRelease lists the commit types that do not cause a release unless
+	// the commit introduces a breaking change. The types are matched
+	// case-insensitively. If NoRelease is nil, the types "build", "chore",
+	// "ci", "docs", "refactor", "style", and "test" do not cause a release.
+	// To make commits of all types cause a release, set NoRelease to an empty,
+	// non-nil slice.
+	NoRelease []string
+}
+
+// Next returns the next version after v for the given commit messages and
+// reports whether the commits cause a release. If they do not, Next returns v.
+// It uses the zero [Analyzer].
+func Next(v *semver.Version, messages []string) (*semver.Version, bool, error) {
+	return (&Analyzer{}).Next(v, messages) //nolint:exhaustruct // the default options
+}
+
+// String returns the name of b, like "minor".
+func (b Bump) String() string {
+	switch b {
+	case None:
+		return "none"
+	case Patch:
+		return "patch"
+	case Minor:
+		return "minor"
+	case Major:
+		return "major"
+	default:
+		return fmt.Sprintf("Bump(%d)", int(b))
+	}
+}
+
+// Bump returns the version increment that the commit messages require. It is
+// the greatest increment that any of the commits requires. A breaking change
+// requires a major increment, a commit of the type "feat" a minor increment,
+// and a commit of any other type a patch increment, unless the type is one of
+// the types that do not cause a release. The messages that do not follow
+// the Conventional Commits specification are ignored.
+func (a *Analyzer) Bump(messages []string) Bump {
+	b := None
+
+	for _, msg := range messages {
+		c, err := Parse(msg)
+		if err != nil {
+			continue
+		}
+
+		if b = max(b, a.bump(c)); b == Major {
+			break
+		}
+	}
+
+	return b
+}
+
+// Next returns the next version after v for the given commit messages and
+// reports whether the commits cause a release. If they do not, Next returns v.
+// While the major version of v is zero, a breaking change increments the minor
+// version instead of the major version, so "0.3.1" is incremented to "0.4.0".
+// The versions are incremented like [semver.Version.IncMajor],
+// [semver.Version.IncMinor], and [semver.Version.IncPatch] increment them, so
+// Next returns an error that wraps [semver.ErrOverflow] if the incremented
+// number would overflow.
+func (a *Analyzer) Next(v *semver.Version, messages []string) (*semver.Version, bool, error) {
+	b := a.Bump(messages)
+	if b == Major && v.Major == 0 {
+		b = Minor
+	}
+
+	var (
+		next *semver.Version
+		err  error
+	)
+
+	switch b {
+	case Major:
+		next, err = v.IncMajor()
+	case Minor:
+		next, err = v.IncMinor()
+	case Patch:
+		next, err = v.IncPatch()
+	case None:
+		return v, false, nil
+	default:
+		panic(fmt.Sprintf("invalid version increment: %v", b))
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to increment the %s version: %w", b, err)
+	}
+
+	return next, true, nil
+}
+
+// bump returns the version increment that c requires.
+func (a *Analyzer) bump(c *Commit) Bump {
+	switch {
+	case c.Breaking:
+		return Major
+	case c.Type == "feat":
+		return Minor
+	case slices.ContainsFunc(a.noRelease(), func(t string) bool { return strings.EqualFold(t, c.Type) }):
+		return None
+	default:
+		return Patch
+	}
+}
+
+// noRelease returns the commit types that do not cause a release.
+func (a *Analyzer) noRelease() []string {
+	if a.NoRelease == nil {
+		return []string{"build", "chore", "ci", "docs", "refactor", "style", "test"}
+	}
+
+	return a.NoRelease
+}
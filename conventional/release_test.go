@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conventional_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/conventional"
+)
+
+func TestBump(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		analyzer conventional.Analyzer
+		messages []string
+		want     conventional.Bump
+	}{
+		{"no messages", conventional.Analyzer{}, nil, conventional.None},
+		{"fix", conventional.Analyzer{}, []string{"fix: x"}, conventional.Patch},
+		{"perf", conventional.Analyzer{}, []string{"perf: x"}, conventional.Patch},
+		{"feat", conventional.Analyzer{}, []string{"fix: x", "feat: y", "fix: z"}, conventional.Minor},
+		{"breaking", conventional.Analyzer{}, []string{"feat: y", "fix!: x"}, conventional.Major},
+		{"breaking footer", conventional.Analyzer{}, []string{"docs: x\n\nBREAKING CHANGE: y"}, conventional.Major},
+		{"no release", conventional.Analyzer{}, []string{"docs: x", "chore(deps): y", "CI: z"}, conventional.None},
+		{"not conventional", conventional.Analyzer{}, []string{"Merge branch 'main'", "wip"}, conventional.None},
+		{"mixed", conventional.Analyzer{}, []string{"wip", "test: x", "fix: y"}, conventional.Patch},
+		{
+			"custom no release",
+			conventional.Analyzer{NoRelease: []string{"Deps"}},
+			[]string{"deps: bump", "docs: x"},
+			conventional.Patch,
+		},
+		{
+			"custom no release only",
+			conventional.Analyzer{NoRelease: []string{"deps", "docs"}},
+			[]string{"deps: bump", "docs: x"},
+			conventional.None,
+		},
+		{"all release", conventional.Analyzer{NoRelease: []string{}}, []string{"chore: x"}, conventional.Patch},
+		{
+			"breaking no release type",
+			conventional.Analyzer{NoRelease: []string{"deps"}},
+			[]string{"deps!: drop Go 1.22"},
+			conventional.Major,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.analyzer.Bump(tt.messages); got != tt.want {
+				t.Errorf("Analyzer.Bump(%q) = %v, want %v", tt.messages, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version  string
+		messages []string
+		want     string
+		release  bool
+	}{
+		{"1.4.2", []string{"fix: x"}, "1.4.3", true},
+		{"1.4.2", []string{"fix: x", "feat: y"}, "1.5.0", true},
+		{"1.4.2", []string{"feat!: y"}, "2.0.0", true},
+		{"1.4.2+build", []string{"docs: x"}, "1.4.2+build", false},
+		{"1.4.2", nil, "1.4.2", false},
+		{"0.3.1", []string{"feat!: y"}, "0.4.0", true},
+		{"0.3.1", []string{"fix: x\n\nBREAKING CHANGE: z"}, "0.4.0", true},
+		{"0.3.1", []string{"feat: y"}, "0.4.0", true},
+		{"0.3.1", []string{"fix: y"}, "0.3.2", true},
+		{"0.0.1", []string{"fix!: y"}, "0.1.0", true},
+		{"2.0.0-rc.1", []string{"feat!: y"}, "2.0.0", true},
+		{"1.3.0-rc.1", []string{"fix: y"}, "1.3.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt.version)
+
+			got, ok, err := conventional.Next(v, tt.messages)
+			if err != nil {
+				t.Fatalf("Next(%q, %q) returned an error: %v", tt.version, tt.messages, err)
+			}
+
+			if got.String() != tt.want || ok != tt.release {
+				t.Errorf("Next(%q, %q) = %q, %v, want %q, %v", tt.version, tt.messages, got, ok, tt.want, tt.release)
+			}
+
+			if !ok && got != v {
+				t.Errorf("Next(%q, %q) did not return the same version", tt.version, tt.messages)
+			}
+		})
+	}
+}
+
+func TestNextOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version  string
+		messages []string
+	}{
+		{"18446744073709551615.0.0", []string{"feat!: y"}},
+		{"0.18446744073709551615.0", []string{"feat: y"}},
+		{"1.2.18446744073709551615", []string{"fix: x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok, err := conventional.Next(semver.MustParse(tt.version), tt.messages)
+			if !errors.Is(err, semver.ErrOverflow) {
+				t.Errorf("Next(%q, %q) = %v, %v, %v, want ErrOverflow", tt.version, tt.messages, got, ok, err)
+			}
+		})
+	}
+}
+
+func TestBumpString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		b    conventional.Bump
+		want string
+	}{
+		{conventional.None, "none"},
+		{conventional.Patch, "patch"},
+		{conventional.Minor, "minor"},
+		{conventional.Major, "major"},
+		{conventional.Bump(42), "Bump(42)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.b.String(); got != tt.want {
+			t.Errorf("Bump(%d).String() = %q, want %q", int(tt.b), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A LaxVersion is a [Version] that is decoded from text using [ParseLax]
+// instead of [Parse]. It can be used in place of Version in, for example,
+// configuration structs that should accept partial version numbers like "v1"
+// or "1.2".
+type LaxVersion struct {
+	Version
+}
+
+// AppendText implements the [encoding.TextAppender] interface. It appends
+// the string representation of v, as returned by [Version.String], to b.
+func (v Version) AppendText(b []byte) ([]byte, error) {
+	b = v.appendCore(b)
+
+	if len(v.Prerelease) > 0 {
+		b = append(b, '-')
+		b, _ = v.Prerelease.AppendText(b)
+	}
+
+	if len(v.Build) > 0 {
+		b = append(b, '+')
+		b, _ = v.Build.AppendText(b)
+	}
+
+	return b, nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The text
+// form of v is the same as the one returned by [Version.String].
+func (v Version) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. It parses
+// the text using [Parse], so the text must be a full version string. To accept
+// partial version strings, use [LaxVersion].
+func (v *Version) UnmarshalText(text []byte) error {
+	w, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = *w
+
+	return nil
+}
+
+// AppendText implements the [encoding.TextAppender] interface. It appends
+// the string representation of the version to b.
+func (v LaxVersion) AppendText(b []byte) ([]byte, error) {
+	return v.Version.AppendText(b)
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The text form
+// is the full version string, so it is the same as for [Version].
+func (v LaxVersion) MarshalText() ([]byte, error) {
+	return v.Version.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. It parses
+// the text using [ParseLax], so the text may be a partial version string.
+func (v *LaxVersion) UnmarshalText(text []byte) error {
+	w, err := ParseLax(string(text))
+	if err != nil {
+		return err
+	}
+
+	v.Version = *w
+
+	return nil
+}
+
+// AppendText implements the [encoding.TextAppender] interface. It appends
+// the string representation of p, as returned by [Prerelease.String], to b.
+func (p Prerelease) AppendText(b []byte) ([]byte, error) {
+	for i, ident := range p {
+		if i > 0 {
+			b = append(b, '.')
+		}
+
+		if id := identifierOf(ident); id.s != "" {
+			b = append(b, id.s...)
+		} else {
+			b = strconv.AppendUint(b, id.n, 10)
+		}
+	}
+
+	return b, nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The text
+// form of p is the same as the one returned by [Prerelease.String].
+func (p Prerelease) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. The text
+// must be the pre-release identifiers separated by dots, without the leading
+// hyphen. Empty text results in an empty Prerelease.
+func (p *Prerelease) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = nil
+
+		return nil
+	}
+
+	s := string(text)
+	parts := strings.Split(s, ".")
+	identifiers := make(Prerelease, 0, len(parts))
+	offset := 0
+
+	for _, part := range parts {
+		ident, err := parsePrereleaseIdentifier(part, false)
+		if err != nil {
+			return fmt.Errorf(
+				"parsing pre-release %q failed: %w",
+				s,
+				rebaseParseError(err, s, offset),
+			)
+		}
+
+		identifiers = append(identifiers, ident)
+		offset += len(part) + 1
+	}
+
+	*p = identifiers
+
+	return nil
+}
+
+// AppendText implements the [encoding.TextAppender] interface. It appends
+// the string representation of b, as returned by [Build.String], to dst.
+func (b Build) AppendText(dst []byte) ([]byte, error) {
+	for i, s := range b {
+		if i > 0 {
+			dst = append(dst, '.')
+		}
+
+		dst = append(dst, s...)
+	}
+
+	return dst, nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The text
+// form of b is the same as the one returned by [Build.String].
+func (b Build) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. The text
+// must be the build identifiers separated by dots, without the leading plus
+// sign. Empty text results in an empty Build.
+func (b *Build) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = nil
+
+		return nil
+	}
+
+	build, err := parseBuild(string(text))
+	if err != nil {
+		return fmt.Errorf("failed to parse the build identifiers: %w", err)
+	}
+
+	*b = build
+
+	return nil
+}
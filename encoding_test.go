@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionAppendText(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParse("v1.2.3-beta.1+darwin.amd64")
+
+	got, err := v.AppendText([]byte("version "))
+	if err != nil {
+		t.Fatalf("AppendText() failed unexpectedly: %v", err)
+	}
+
+	if want := "version 1.2.3-beta.1+darwin.amd64"; string(got) != want {
+		t.Errorf("AppendText() = %q, want %q", got, want)
+	}
+}
+
+func TestVersionJSON(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Version    *semver.Version   `json:"version"`
+		Min        semver.LaxVersion `json:"min"`
+		Prerelease semver.Prerelease `json:"prerelease"`
+		Build      semver.Build      `json:"build"`
+	}
+
+	in := `{"version":"1.2.3-rc.1+sha.abc","min":"v2","prerelease":"alpha.7","build":"exp.5"}`
+
+	var c config
+	if err := json.Unmarshal([]byte(in), &c); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed unexpectedly: %v", in, err)
+	}
+
+	if want := semver.MustParse("1.2.3-rc.1+sha.abc"); !c.Version.StrictEqual(want) {
+		t.Errorf("json.Unmarshal(%s).Version = %v, want %v", in, c.Version, want)
+	}
+
+	if want := semver.MustParse("2.0.0"); !c.Min.StrictEqual(want) {
+		t.Errorf("json.Unmarshal(%s).Min = %v, want %v", in, &c.Min.Version, want)
+	}
+
+	if got := c.Prerelease.String(); got != "alpha.7" {
+		t.Errorf("json.Unmarshal(%s).Prerelease = %q, want %q", in, got, "alpha.7")
+	}
+
+	if got := c.Build.String(); got != "exp.5" {
+		t.Errorf("json.Unmarshal(%s).Build = %q, want %q", in, got, "exp.5")
+	}
+
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) failed unexpectedly: %v", c, err)
+	}
+
+	want := `{"version":"1.2.3-rc.1+sha.abc","min":"2.0.0","prerelease":"alpha.7","build":"exp.5"}`
+	if string(out) != want {
+		t.Errorf("json.Marshal() = %s, want %s", out, want)
+	}
+}
+
+func TestVersionJSONValue(t *testing.T) {
+	t.Parallel()
+
+	type release struct {
+		Version semver.Version `json:"version"`
+	}
+
+	in := release{Version: *semver.MustParse("1.2.3-rc.1+sha.abc")}
+
+	out, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) failed unexpectedly: %v", in, err)
+	}
+
+	if want := `{"version":"1.2.3-rc.1+sha.abc"}`; string(out) != want {
+		t.Errorf("json.Marshal() = %s, want %s", out, want)
+	}
+
+	var got release
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed unexpectedly: %v", out, err)
+	}
+
+	if !got.Version.StrictEqual(&in.Version) {
+		t.Errorf("json.Unmarshal(%s).Version = %v, want %v", out, &got.Version, &in.Version)
+	}
+}
+
+func TestVersionJSONMapKey(t *testing.T) {
+	t.Parallel()
+
+	m := map[*semver.Version]int{semver.MustParse("1.0.0-beta"): 1}
+
+	out, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) failed unexpectedly: %v", m, err)
+	}
+
+	if want := `{"1.0.0-beta":1}`; string(out) != want {
+		t.Errorf("json.Marshal() = %s, want %s", out, want)
+	}
+}
+
+func TestVersionUnmarshalTextError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		dst  interface{ UnmarshalText(text []byte) error }
+		in   string
+	}{
+		{"Version/partial", &semver.Version{}, "1.2"},
+		{"Version/empty", &semver.Version{}, ""},
+		{"LaxVersion/invalid", &semver.LaxVersion{}, "1.2.x"},
+		{"Prerelease/leading-zero", &semver.Prerelease{}, "alpha.01"},
+		{"Prerelease/empty-identifier", &semver.Prerelease{}, "alpha..1"},
+		{"Build/invalid", &semver.Build{}, "exp_5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.dst.UnmarshalText([]byte(tt.in))
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Errorf("UnmarshalText(%q) = %v, want ErrInvalidVersion", tt.in, err)
+			}
+		})
+	}
+}
+
+func TestVersionXML(t *testing.T) {
+	t.Parallel()
+
+	type pkg struct {
+		XMLName xml.Name        `xml:"package"`
+		Version *semver.Version `xml:"version,attr"`
+	}
+
+	in := `<package version="0.4.2-alpha"></package>`
+
+	var p pkg
+	if err := xml.Unmarshal([]byte(in), &p); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) failed unexpectedly: %v", in, err)
+	}
+
+	out, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatalf("xml.Marshal(%+v) failed unexpectedly: %v", p, err)
+	}
+
+	if string(out) != in {
+		t.Errorf("xml.Marshal() = %s, want %s", out, in)
+	}
+}
+
+func TestVersionFlag(t *testing.T) {
+	t.Parallel()
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var v semver.Version
+	fs.TextVar(&v, "version", semver.MustParse("1.0.0"), "version to use")
+
+	if err := fs.Parse([]string{"-version", "v3.1.4"}); err != nil {
+		t.Fatalf("Parse() failed unexpectedly: %v", err)
+	}
+
+	if want := semver.MustParse("3.1.4"); !v.StrictEqual(want) {
+		t.Errorf("flag value = %v, want %v", &v, want)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+)
+
+// Values for the kind of a parse error.
+const (
+	// KindEmpty is the kind of error for an empty version string.
+	KindEmpty ErrorKind = iota
+
+	// KindNonASCII is the kind of error for a version string that contains
+	// non-ASCII characters.
+	KindNonASCII
+
+	// KindBadPrefix is the kind of error for a version string that does not
+	// start with a digit or an allowed prefix.
+	KindBadPrefix
+
+	// KindTooManyCoreNumbers is the kind of error for a version string that has
+	// more than three core version numbers.
+	KindTooManyCoreNumbers
+
+	// KindTooFewCoreNumbers is the kind of error for a version string that has
+	// fewer core version numbers than required.
+	KindTooFewCoreNumbers
+
+	// KindEmptyIdentifier is the kind of error for an empty core version
+	// number, pre-release identifier, or build identifier.
+	KindEmptyIdentifier
+
+	// KindLeadingZero is the kind of error for a number with a leading zero.
+	KindLeadingZero
+
+	// KindInvalidCharacter is the kind of error for a character that is not
+	// allowed in its position in the version string.
+	KindInvalidCharacter
+
+	// KindOverflow is the kind of error for a number that does not fit in
+	// uint64.
+	KindOverflow
+)
+
+// Values for the component of a version string.
+const (
+	// ComponentPrefix is the prefix of the version string, like 'v'.
+	ComponentPrefix Component = iota
+
+	// ComponentCore is the core version, "<major>.<minor>.<patch>".
+	ComponentCore
+
+	// ComponentPrerelease is the pre-release part of the version string after
+	// the hyphen.
+	ComponentPrerelease
+
+	// ComponentBuild is the build metadata of the version string after the plus
+	// sign.
+	ComponentBuild
+)
+
+// A ParseError describes a problem in an invalid version string. The parsing
+// functions return it wrapped in their errors, so it can be retrieved using
+// [errors.As]. A ParseError matches [ErrInvalidVersion] when checked using
+// [errors.Is].
+type ParseError struct {
+	// Err is the underlying error that caused the problem, if any. For
+	// example, for the errors of kind [KindOverflow] it is the error from
+	// [strconv.ParseUint].
+	Err error
+
+	// Input is the version string that was parsed.
+	Input string
+
+	// Offset is the byte offset of the invalid part in Input.
+	Offset int
+
+	// Length is the length of the invalid part in bytes. It is zero if
+	// the problem is a missing part, like an empty identifier.
+	Length int
+
+	// Kind tells what kind of problem was found.
+	Kind ErrorKind
+
+	// Component is the part of the version string that is invalid.
+	Component Component
+}
+
+// An ErrorKind is the kind of problem that a [ParseError] describes.
+type ErrorKind int
+
+// A Component is a part of a version string.
+type Component int
+
+// Error returns the error message of e.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf(
+		"%s %q: %s in %s at offset %d",
+		ErrInvalidVersion,
+		e.Input,
+		e.Kind,
+		e.Component,
+		e.Offset,
+	)
+}
+
+// Unwrap returns [ErrInvalidVersion] and the underlying error of e, if any.
+func (e *ParseError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{ErrInvalidVersion, e.Err}
+	}
+
+	return []error{ErrInvalidVersion}
+}
+
+// String returns the description of the error kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case KindEmpty:
+		return "empty string"
+	case KindNonASCII:
+		return "non-ASCII character"
+	case KindBadPrefix:
+		return "invalid prefix"
+	case KindTooManyCoreNumbers:
+		return "too many core version numbers"
+	case KindTooFewCoreNumbers:
+		return "not enough core version numbers"
+	case KindEmptyIdentifier:
+		return "empty identifier"
+	case KindLeadingZero:
+		return "leading zero"
+	case KindInvalidCharacter:
+		return "invalid character"
+	case KindOverflow:
+		return "number out of range"
+	default:
+		return fmt.Sprintf("ErrorKind(%d)", int(k))
+	}
+}
+
+// String returns the name of the component.
+func (c Component) String() string {
+	switch c {
+	case ComponentPrefix:
+		return "prefix"
+	case ComponentCore:
+		return "core version"
+	case ComponentPrerelease:
+		return "pre-release"
+	case ComponentBuild:
+		return "build metadata"
+	default:
+		return fmt.Sprintf("Component(%d)", int(c))
+	}
+}
+
+// newParseError returns a new ParseError. If the character at offset is not
+// an ASCII character, the kind of the error is set to [KindNonASCII].
+func newParseError(s string, offset, length int, kind ErrorKind, comp Component) *ParseError {
+	if length > 0 && offset < len(s) && s[offset] > unicode.MaxASCII {
+		kind = KindNonASCII
+	}
+
+	return &ParseError{
+		Err:       nil,
+		Input:     s,
+		Offset:    offset,
+		Length:    length,
+		Kind:      kind,
+		Component: comp,
+	}
+}
+
+// rebaseParseError moves the ParseError in err, that was created for a part of
+// a version string, to point to the whole version string s where the part
+// starts at offset.
+func rebaseParseError(err error, s string, offset int) error {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		pe.Input = s
+		pe.Offset += offset
+	}
+
+	return err
+}
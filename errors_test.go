@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v      string
+		lax    bool
+		offset int
+		length int
+		kind   semver.ErrorKind
+		comp   semver.Component
+	}{
+		{"", false, 0, 0, semver.KindEmpty, semver.ComponentCore},
+		{"x1.2.3", false, 0, 1, semver.KindBadPrefix, semver.ComponentPrefix},
+		{"\xff1.2.3", false, 0, 1, semver.KindNonASCII, semver.ComponentPrefix},
+		{"v", false, 1, 0, semver.KindEmptyIdentifier, semver.ComponentCore},
+		{"1.2", false, 3, 0, semver.KindTooFewCoreNumbers, semver.ComponentCore},
+		{"1.2.3.4", false, 5, 2, semver.KindTooManyCoreNumbers, semver.ComponentCore},
+		{"1.2.3.4", true, 5, 2, semver.KindTooManyCoreNumbers, semver.ComponentCore},
+		{"1..3", false, 2, 0, semver.KindEmptyIdentifier, semver.ComponentCore},
+		{"v1.02.3", false, 3, 2, semver.KindLeadingZero, semver.ComponentCore},
+		{"1.2.99999999999999999999", false, 4, 20, semver.KindOverflow, semver.ComponentCore},
+		{"1.2.3_beta", false, 5, 1, semver.KindInvalidCharacter, semver.ComponentCore},
+		{"1.2.3\xff", false, 5, 1, semver.KindNonASCII, semver.ComponentCore},
+		{"1.2.3-", false, 6, 0, semver.KindEmptyIdentifier, semver.ComponentPrerelease},
+		{"1.2.3-beta..1", false, 11, 0, semver.KindEmptyIdentifier, semver.ComponentPrerelease},
+		{"1.2.3-beta.01", false, 11, 2, semver.KindLeadingZero, semver.ComponentPrerelease},
+		{"1.2.3-be_ta", false, 8, 1, semver.KindInvalidCharacter, semver.ComponentPrerelease},
+		{"1.2.3-beta.é", false, 11, 1, semver.KindNonASCII, semver.ComponentPrerelease},
+		{
+			"1.2.3-99999999999999999999",
+			false,
+			6,
+			20,
+			semver.KindOverflow,
+			semver.ComponentPrerelease,
+		},
+		{"1.2.3+", false, 6, 0, semver.KindEmptyIdentifier, semver.ComponentBuild},
+		{"1.2.3-rc+a..b", false, 11, 0, semver.KindEmptyIdentifier, semver.ComponentBuild},
+		{"1.2+a.b_c", true, 7, 1, semver.KindInvalidCharacter, semver.ComponentBuild},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Quote(tt.v), func(t *testing.T) {
+			t.Parallel()
+
+			parse := semver.Parse
+			if tt.lax {
+				parse = semver.ParseLax
+			}
+
+			_, err := parse(tt.v)
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Fatalf("Parse(%q) error = %v, want ErrInvalidVersion", tt.v, err)
+			}
+
+			var pe *semver.ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("Parse(%q) error = %v, want *ParseError", tt.v, err)
+			}
+
+			if pe.Input != tt.v {
+				t.Errorf("Parse(%q) ParseError.Input = %q, want %q", tt.v, pe.Input, tt.v)
+			}
+
+			if pe.Offset != tt.offset || pe.Length != tt.length {
+				t.Errorf(
+					"Parse(%q) ParseError offset and length = %d, %d, want %d, %d",
+					tt.v,
+					pe.Offset,
+					pe.Length,
+					tt.offset,
+					tt.length,
+				)
+			}
+
+			if pe.Kind != tt.kind {
+				t.Errorf("Parse(%q) ParseError.Kind = %v, want %v", tt.v, pe.Kind, tt.kind)
+			}
+
+			if pe.Component != tt.comp {
+				t.Errorf("Parse(%q) ParseError.Component = %v, want %v", tt.v, pe.Component, tt.comp)
+			}
+		})
+	}
+}
+
+func TestParseErrorOverflowCause(t *testing.T) {
+	t.Parallel()
+
+	_, err := semver.Parse("99999999999999999999.0.0")
+
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Parse() error = %v, want strconv.ErrRange", err)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A Match is a version that was found in text by [Find] or [FindAll].
+type Match struct {
+	// Version is the found version normalized like [ParseLax] normalizes
+	// the versions.
+	Version *Version
+
+	// Start is the byte offset where the version starts in the text.
+	Start int
+
+	// End is the byte offset where the version ends in the text, so
+	// the version is text[Start:End].
+	End int
+}
+
+// Find returns the first version in s and reports whether a version was found.
+// It works like [FindAll] but stops at the first version.
+func Find(s string) (Match, bool) {
+	m, _, ok := find(s, 0)
+
+	return m, ok
+}
+
+// FindAll returns all of the versions in s in the order they appear in s.
+//
+// A version in text is a sequence of one to three numbers separated by dots
+// that is not preceded by a digit, like "2.4" in "release notes for v2.4".
+// Sequences of more than three numbers, like IP addresses, and numbers that do
+// not fit in uint64 are not versions. The numbers may have leading zeros, and
+// the missing minor and patch versions are set to zero. If the version has all
+// three numbers, it may be followed by pre-release and build identifiers, and
+// the longest valid part of them is included in the version. The prefix of
+// the version, like "v", is not included in the match.
+func FindAll(s string) []Match {
+	var matches []Match
+
+	for pos := 0; ; {
+		m, next, ok := find(s, pos)
+		if !ok {
+			return matches
+		}
+
+		matches = append(matches, m)
+		pos = next
+	}
+}
+
+// Coerce returns the first version in s like [Find], normalized like
+// [ParseLax] normalizes the versions, so "release notes for v2.4 (build 17)"
+// is coerced into "2.4.0". It returns an error if s contains no version.
+func Coerce(s string) (*Version, error) {
+	m, ok := Find(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: no version found in %q", ErrInvalidVersion, s)
+	}
+
+	return m.Version, nil
+}
+
+// find returns the first version in s that starts at or after pos, and
+// the position where the search for the next version continues.
+func find(s string, pos int) (Match, int, bool) {
+	p := Parser{Prefixes: []string{}, MinCore: 1, AllowLeadingZeros: true}
+
+	for i := pos; i < len(s); i++ {
+		if !isDigit(s[i]) || (i > 0 && isDigit(s[i-1])) {
+			continue
+		}
+
+		end, n := scanNumbers(s, i)
+		if n > 3 { //nolint:mnd // <major>.<minor>.<patch>
+			i = end
+
+			continue
+		}
+
+		// Only full versions may have pre-release and build identifiers.
+		tail := end
+		if n == 3 && end < len(s) && (s[end] == '-' || s[end] == '+') {
+			tail = scanTail(s, end)
+		}
+
+		if v, j, ok := parseFound(&p, s, i, end, tail); ok {
+			return Match{Version: v, Start: i, End: j}, j, true
+		}
+
+		i = end
+	}
+
+	return Match{Version: nil, Start: 0, End: 0}, len(s), false
+}
+
+// scanNumbers scans the numbers separated by dots that start at pos in s. It
+// returns the position where the numbers end and the number of them.
+func scanNumbers(s string, pos int) (int, int) {
+	n := 0
+
+	for {
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+
+		n++
+
+		if pos+1 >= len(s) || s[pos] != '.' || !isDigit(s[pos+1]) {
+			return pos, n
+		}
+
+		pos++
+	}
+}
+
+// scanTail scans the characters that may be part of the pre-release and build
+// identifiers starting at pos in s and returns the position where they end.
+func scanTail(s string, pos int) int {
+	for pos < len(s) && (isIdentifierCharacter(s[pos]) || s[pos] == '.' || s[pos] == '+') {
+		pos++
+	}
+
+	return pos
+}
+
+// parseFound parses the version that starts at start in s. The core version
+// ends at end, and the pre-release and build identifiers may extend to tail.
+// If the identifiers are invalid, the longest valid part of them is used.
+// parseFound returns the version, the position where it ends, and whether
+// the version is valid.
+func parseFound(p *Parser, s string, start, end, tail int) (*Version, int, bool) {
+	for {
+		// Drop the separators that are not followed by an identifier.
+		for tail > end && s[tail-1] == '.' {
+			tail--
+		}
+
+		if tail == end+1 {
+			tail = end
+		}
+
+		v, err := p.parse(s[start:tail])
+		if err == nil {
+			return v, tail, true
+		}
+
+		var pe *ParseError
+		if !errors.As(err, &pe) || start+pe.Offset <= end || tail == end {
+			return nil, 0, false
+		}
+
+		// Cut the identifiers before the problem. If the problem is at
+		// the end, like in an empty identifier after "+", drop the last
+		// character.
+		tail = min(start+pe.Offset, tail-1)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Parallel()
+
+	type match struct {
+		version string
+		text    string
+	}
+
+	tests := []struct {
+		input string
+		want  []match
+	}{
+		{"release notes for v2.4 (build 17)", []match{{"2.4.0", "2.4"}, {"17.0.0", "17"}}},
+		{"", nil},
+		{"no versions here", nil},
+		{"1.2.3", []match{{"1.2.3", "1.2.3"}}},
+		{"v1.2.3-beta.1+exp.sha.5114f85, and more", []match{{"1.2.3-beta.1+exp.sha.5114f85", "1.2.3-beta.1+exp.sha.5114f85"}}},
+		{"tool-1.2.3.tar.gz", []match{{"1.2.3", "1.2.3"}}},
+		{"Upgraded to 1.2.3.", []match{{"1.2.3", "1.2.3"}}},
+		{"version 1.2.3-rc.1.", []match{{"1.2.3-rc.1", "1.2.3-rc.1"}}},
+		{"1.2.3-", []match{{"1.2.3", "1.2.3"}}},
+		{"1.2.3-rc+", []match{{"1.2.3-rc", "1.2.3-rc"}}},
+		{"1.2.3-rc..1", []match{{"1.2.3-rc", "1.2.3-rc"}, {"1.0.0", "1"}}},
+		{"1.2.3-rc_1", []match{{"1.2.3-rc", "1.2.3-rc"}, {"1.0.0", "1"}}},
+		{"1.2-beta", []match{{"1.2.0", "1.2"}}},
+		{"from 1.0 to 2.0.1", []match{{"1.0.0", "1.0"}, {"2.0.1", "2.0.1"}}},
+		{"host 192.168.0.1 runs 3.1", []match{{"3.1.0", "3.1"}}},
+		{"v01.02.03", []match{{"1.2.3", "01.02.03"}}},
+		{"big 99999999999999999999.1 small 1", []match{{"1.0.0", "1"}}},
+		{"python3.11", []match{{"3.11.0", "3.11"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			var got []match
+
+			for _, m := range semver.FindAll(tt.input) {
+				got = append(got, match{m.Version.String(), tt.input[m.Start:m.End]})
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			m, ok := semver.Find(tt.input)
+			if ok != (len(tt.want) > 0) {
+				t.Fatalf("Find(%q) found %t, want %t", tt.input, ok, len(tt.want) > 0)
+			}
+
+			if ok && m.Version.String() != tt.want[0].version {
+				t.Errorf("Find(%q) = %q, want %q", tt.input, m.Version, tt.want[0].version)
+			}
+		})
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"release notes for v2.4 (build 17)", "2.4.0"},
+		{"v3", "3.0.0"},
+		{"go version go1.22.5 linux/amd64", "1.22.5"},
+		{"1.2.3-rc.1+build", "1.2.3-rc.1+build"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := semver.Coerce(tt.input)
+			if err != nil {
+				t.Fatalf("Coerce(%q) failed unexpectedly: %v", tt.input, err)
+			}
+
+			if v.String() != tt.want {
+				t.Errorf("Coerce(%q) = %q, want %q", tt.input, v, tt.want)
+			}
+		})
+	}
+
+	if v, err := semver.Coerce("none"); !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("Coerce(%q) = %v, %v, want ErrInvalidVersion", "none", v, err)
+	}
+}
+
+func FuzzFindAll(f *testing.F) {
+	f.Add("release notes for v2.4 (build 17)")
+	f.Add("1.2.3-rc..1+")
+	f.Add("host 192.168.0.1")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		prev := 0
+
+		for _, m := range semver.FindAll(s) {
+			if m.Start < prev || m.End <= m.Start || m.End > len(s) {
+				t.Fatalf("FindAll(%q) returned invalid span [%d, %d)", s, m.Start, m.End)
+			}
+
+			if _, err := semver.ParseLax(m.Version.String()); err != nil {
+				t.Fatalf("FindAll(%q) returned invalid version %q: %v", s, m.Version, err)
+			}
+
+			prev = m.End
+		}
+	})
+}
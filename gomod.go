@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+// incompatible is the build suffix that the Go toolchain adds to the versions
+// of modules that have a major version of 2 or greater but no go.mod file.
+const incompatible = "+incompatible"
+
+// IsGoModuleValid reports whether v is a valid Go module version string, like
+// golang.org/x/mod/semver.IsValid. The version string must start with "v", and
+// it may omit the patch version or both the minor and the patch version, like
+// "v1.2" or "v1", if it has no pre-release or build identifiers. The numbers in
+// the version may be arbitrarily large.
+func IsGoModuleValid(v string) bool {
+	return parseGoModule(v) != nil
+}
+
+// Canonical returns the canonical formatting of the Go module version string
+// v, like golang.org/x/mod/semver.Canonical. It adds the omitted minor and
+// patch versions and removes the build metadata, so "v1.2" becomes "v1.2.0"
+// and "v1.2.3-rc.1+meta" becomes "v1.2.3-rc.1". It returns an empty string if
+// v is not valid according to [IsGoModuleValid].
+//
+// Canonical also removes the "+incompatible" suffix. To keep it, use
+// [CanonicalModule].
+func Canonical(v string) string {
+	w := parseGoModule(v)
+	if w == nil {
+		return ""
+	}
+
+	b := append(make([]byte, 0, len(v)+4), 'v') //nolint:mnd // room for ".0.0"
+	b = w.appendCore(b)
+
+	if len(w.Prerelease) > 0 {
+		b = append(b, '-')
+		b, _ = w.Prerelease.AppendText(b)
+	}
+
+	return string(b)
+}
+
+// CanonicalModule returns the canonical formatting of the Go module version
+// string v like [Canonical], but it keeps the "+incompatible" build suffix,
+// like golang.org/x/mod/module.CanonicalVersion. For example,
+// "v2.0.0+incompatible" stays as it is. It returns an empty string if v is not
+// valid according to [IsGoModuleValid].
+func CanonicalModule(v string) string {
+	c := Canonical(v)
+	if c != "" && IsIncompatible(v) {
+		c += incompatible
+	}
+
+	return c
+}
+
+// IsIncompatible reports whether v is a valid Go module version string that
+// has the build suffix "+incompatible", like "v2.0.0+incompatible". The Go
+// toolchain uses the suffix for the versions of modules that have a major
+// version of 2 or greater but do not have a go.mod file.
+func IsIncompatible(v string) bool {
+	w := parseGoModule(v)
+
+	return w != nil && len(w.Build) == 1 && w.Build[0] == incompatible[1:]
+}
+
+// Major returns the major version prefix of the Go module version string v,
+// like golang.org/x/mod/semver.Major. For example, it returns "v2" for
+// "v2.1.0". It returns an empty string if v is not valid according to
+// [IsGoModuleValid].
+func Major(v string) string {
+	w := parseGoModule(v)
+	if w == nil {
+		return ""
+	}
+
+	d := w.bigDigits()
+
+	return string(appendNumber([]byte{'v'}, w.Major, d[0]))
+}
+
+// MajorMinor returns the major and minor version prefix of the Go module
+// version string v, like golang.org/x/mod/semver.MajorMinor. For example, it
+// returns "v2.1" for "v2.1.0" and "v1.0" for "v1". It returns an empty string
+// if v is not valid according to [IsGoModuleValid].
+func MajorMinor(v string) string {
+	w := parseGoModule(v)
+	if w == nil {
+		return ""
+	}
+
+	d := w.bigDigits()
+	b := appendNumber([]byte{'v'}, w.Major, d[0])
+	b = append(b, '.')
+	b = appendNumber(b, w.Minor, d[1])
+
+	return string(b)
+}
+
+// parseGoModule parses a Go module version string. It returns nil if v is not
+// valid according to [IsGoModuleValid].
+func parseGoModule(v string) *Version {
+	if v == "" || v[0] != 'v' {
+		return nil
+	}
+
+	w, err := (&Parser{MinCore: 1, AllowBig: true, KeepOriginal: true}).parse(v)
+	if err != nil {
+		return nil
+	}
+
+	if w.CoreCount() < 3 && (len(w.Prerelease) > 0 || len(w.Build) > 0) {
+		return nil
+	}
+
+	return w
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+// xmodVector is a test vector of golang.org/x/mod/semver.
+type xmodVector struct {
+	in  string
+	out string
+}
+
+// readXmodVectors reads the vendored test vectors of golang.org/x/mod/semver.
+func readXmodVectors(t *testing.T) []xmodVector {
+	t.Helper()
+
+	f, err := os.Open("testdata/xmod_semver.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	var vectors []xmodVector
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		in, out, ok := strings.Cut(line, "\t")
+		if !ok {
+			t.Fatalf("invalid test vector %q", line)
+		}
+
+		vectors = append(vectors, xmodVector{in, out})
+	}
+
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	return vectors
+}
+
+// TestGoModuleXmod checks the Go module helpers against the test vectors of
+// golang.org/x/mod/semver the same way as its own tests use them.
+func TestGoModuleXmod(t *testing.T) {
+	t.Parallel()
+
+	vectors := readXmodVectors(t)
+
+	for _, tt := range vectors {
+		if got, want := semver.IsGoModuleValid(tt.in), tt.out != ""; got != want {
+			t.Errorf("IsGoModuleValid(%q) = %v, want %v", tt.in, got, want)
+		}
+
+		if got := semver.Canonical(tt.in); got != tt.out {
+			t.Errorf("Canonical(%q) = %q, want %q", tt.in, got, tt.out)
+		}
+
+		wantMajor := ""
+		if i := strings.Index(tt.out, "."); i >= 0 {
+			wantMajor = tt.out[:i]
+		}
+
+		if got := semver.Major(tt.in); got != wantMajor {
+			t.Errorf("Major(%q) = %q, want %q", tt.in, got, wantMajor)
+		}
+
+		wantMajorMinor := ""
+		if tt.out != "" {
+			wantMajorMinor = tt.in
+			if i := strings.Index(wantMajorMinor, "+"); i >= 0 {
+				wantMajorMinor = wantMajorMinor[:i]
+			}
+
+			if i := strings.Index(wantMajorMinor, "-"); i >= 0 {
+				wantMajorMinor = wantMajorMinor[:i]
+			}
+
+			switch strings.Count(wantMajorMinor, ".") {
+			case 0:
+				wantMajorMinor += ".0"
+			case 1:
+			case 2: //nolint:mnd // <major>.<minor>.<patch>
+				wantMajorMinor = wantMajorMinor[:strings.LastIndex(wantMajorMinor, ".")]
+			}
+		}
+
+		if got := semver.MajorMinor(tt.in); got != wantMajorMinor {
+			t.Errorf("MajorMinor(%q) = %q, want %q", tt.in, got, wantMajorMinor)
+		}
+	}
+
+	// The valid versions are in increasing order, so the Versions parsed
+	// from them must compare in the same order.
+	for i, a := range vectors {
+		for j, b := range vectors {
+			if a.out == "" || b.out == "" {
+				continue
+			}
+
+			want := 0
+
+			switch {
+			case a.out == b.out:
+			case i < j:
+				want = -1
+			default:
+				want = 1
+			}
+
+			v := semver.MustParseLax(a.out)
+			w := semver.MustParseLax(b.out)
+
+			if got := v.Compare(w); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a.in, b.in, got, want)
+			}
+		}
+	}
+}
+
+func TestGoModule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in         string
+		valid      bool
+		canonical  string
+		module     string
+		major      string
+		majorMinor string
+	}{
+		{"", false, "", "", "", ""},
+		{"1.2.3", false, "", "", "", ""},
+		{"V1.2.3", false, "", "", "", ""},
+		{"v01.2.3", false, "", "", "", ""},
+		{"v1.02", false, "", "", "", ""},
+		{"v1.2.3.4", false, "", "", "", ""},
+		{"v1.2.3-01", false, "", "", "", ""},
+		{" v1.2.3", false, "", "", "", ""},
+		{"v2.0.0+incompatible", true, "v2.0.0", "v2.0.0+incompatible", "v2", "v2.0"},
+		{"v2.0.0-rc.1+incompatible", true, "v2.0.0-rc.1", "v2.0.0-rc.1+incompatible", "v2", "v2.0"},
+		{"v2.0.0+incompatible.1", true, "v2.0.0", "v2.0.0", "v2", "v2.0"},
+		{"v2+incompatible", false, "", "", "", ""},
+		{"v1.2.3+meta", true, "v1.2.3", "v1.2.3", "v1", "v1.2"},
+		{"v3", true, "v3.0.0", "v3.0.0", "v3", "v3.0"},
+		{
+			"v18446744073709551616.2.3",
+			true,
+			"v18446744073709551616.2.3",
+			"v18446744073709551616.2.3",
+			"v18446744073709551616",
+			"v18446744073709551616.2",
+		},
+		{
+			"v1.99999999999999999999-0",
+			false,
+			"",
+			"",
+			"",
+			"",
+		},
+		{
+			"v1.99999999999999999999",
+			true,
+			"v1.99999999999999999999.0",
+			"v1.99999999999999999999.0",
+			"v1",
+			"v1.99999999999999999999",
+		},
+		{
+			"v0.0.0-20250101120000-abcdef123456",
+			true,
+			"v0.0.0-20250101120000-abcdef123456",
+			"v0.0.0-20250101120000-abcdef123456",
+			"v0",
+			"v0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			if got := semver.IsGoModuleValid(tt.in); got != tt.valid {
+				t.Errorf("IsGoModuleValid(%q) = %v, want %v", tt.in, got, tt.valid)
+			}
+
+			if got := semver.Canonical(tt.in); got != tt.canonical {
+				t.Errorf("Canonical(%q) = %q, want %q", tt.in, got, tt.canonical)
+			}
+
+			if got := semver.CanonicalModule(tt.in); got != tt.module {
+				t.Errorf("CanonicalModule(%q) = %q, want %q", tt.in, got, tt.module)
+			}
+
+			if got, want := semver.IsIncompatible(tt.in), strings.HasSuffix(tt.module, "+incompatible"); got != want {
+				t.Errorf("IsIncompatible(%q) = %v, want %v", tt.in, got, want)
+			}
+
+			if got := semver.Major(tt.in); got != tt.major {
+				t.Errorf("Major(%q) = %q, want %q", tt.in, got, tt.major)
+			}
+
+			if got := semver.MajorMinor(tt.in); got != tt.majorMinor {
+				t.Errorf("MajorMinor(%q) = %q, want %q", tt.in, got, tt.majorMinor)
+			}
+		})
+	}
+}
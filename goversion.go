@@ -0,0 +1,343 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// goLangMinor is the first minor version of Go that has a separate language
+// version, so "1.21" is less than "1.21.0" but "1.20" equals "1.20.0".
+const goLangMinor = 21
+
+// ErrInvalidGoVersion is returned when a string is not a valid Go toolchain
+// version, or a Version cannot be converted into one.
+var ErrInvalidGoVersion = errors.New("invalid Go version")
+
+// A GoVersion is a Go toolchain version, like "go1.21.0" or "go1.22rc2". Go
+// toolchain versions do not follow the semantic versioning specification:
+// the language version "go1.21" is less than the pre-releases of Go 1.21, like
+// "go1.21rc1", which are less than the release "go1.21.0". Before Go 1.21,
+// the first release of a minor version had no patch version, so "go1.20" is
+// equal to "go1.20.0". The only pre-release kinds that the toolchain uses are
+// "beta" and "rc", but any kind of lower-case letters is accepted.
+//
+// The ordering of GoVersions is the same as the ordering of go/version.Compare.
+type GoVersion struct {
+	major uint64
+	minor uint64
+	patch uint64
+
+	// n is the number of the version numbers in the version string.
+	n int
+
+	// kind is the pre-release kind, like "rc", or an empty string.
+	kind string
+
+	// pre is the number after the pre-release kind, if hasPre is set.
+	pre    uint64
+	hasPre bool
+}
+
+// ParseGoVersion parses a Go toolchain version, like "go1.21rc2". The version
+// string may omit the "go" prefix, like the go lines in go.mod files do.
+// A suffix that starts with '-' or ' ', like in "go1.21.0-custom" or
+// "go1.22.0 X:rangefunc" reported by runtime.Version, is ignored.
+func ParseGoVersion(s string) (*GoVersion, error) {
+	x := strings.TrimPrefix(s, "go")
+	if i := strings.IndexAny(x, "- "); i >= 0 {
+		x = x[:i]
+	}
+
+	v := &GoVersion{n: 1}
+
+	var err error
+
+	if v.major, x, err = cutGoNumber(s, x, "major"); err != nil {
+		return nil, err
+	}
+
+	if x == "" {
+		return v, nil
+	}
+
+	if x[0] != '.' {
+		return nil, fmt.Errorf("%w: unexpected %q after the major version in %q", ErrInvalidGoVersion, x, s)
+	}
+
+	if v.minor, x, err = cutGoNumber(s, x[1:], "minor"); err != nil {
+		return nil, err
+	}
+
+	v.n = 2
+
+	if x == "" {
+		return v, nil
+	}
+
+	if x[0] == '.' {
+		if v.patch, x, err = cutGoNumber(s, x[1:], "patch"); err != nil {
+			return nil, err
+		}
+
+		if x != "" {
+			return nil, fmt.Errorf("%w: unexpected %q after the patch version in %q", ErrInvalidGoVersion, x, s)
+		}
+
+		v.n = 3
+
+		return v, nil
+	}
+
+	i := 0
+	for i < len(x) && x[i] >= 'a' && x[i] <= 'z' {
+		i++
+	}
+
+	if i == 0 {
+		return nil, fmt.Errorf("%w: unexpected %q after the minor version in %q", ErrInvalidGoVersion, x, s)
+	}
+
+	v.kind, x = x[:i], x[i:]
+
+	if x == "" {
+		return v, nil
+	}
+
+	if v.pre, x, err = cutGoNumber(s, x, "pre-release"); err != nil {
+		return nil, err
+	}
+
+	if x != "" {
+		return nil, fmt.Errorf("%w: unexpected %q after the pre-release in %q", ErrInvalidGoVersion, x, s)
+	}
+
+	v.hasPre = true
+
+	return v, nil
+}
+
+// MustParseGoVersion parses the given string into a GoVersion like
+// [ParseGoVersion] and panics if it encounters an error.
+func MustParseGoVersion(s string) *GoVersion {
+	v, err := ParseGoVersion(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a Go version: %v", s, err))
+	}
+
+	return v
+}
+
+// GoVersion converts v into a Go toolchain version. It is the inverse of
+// [GoVersion.Version]: "1.21.0" is converted into "go1.21.0", "1.21.0-0" into
+// the language version "go1.21", and "1.21.0-rc.1" into "go1.21rc1". As Go
+// releases before Go 1.21 had no patch version, "1.20.0" is converted into
+// "go1.20".
+//
+// GoVersion returns an error if v has build metadata, if its pre-release is
+// not a pre-release kind of lower-case letters optionally followed by
+// a number, or if it is a pre-release of a patch version.
+func (v *Version) GoVersion() (*GoVersion, error) {
+	if v.big != nil {
+		return nil, fmt.Errorf("%w: %q has too large numbers", ErrInvalidGoVersion, v)
+	}
+
+	if len(v.Build) > 0 {
+		return nil, fmt.Errorf("%w: %q has build metadata", ErrInvalidGoVersion, v)
+	}
+
+	g := &GoVersion{major: v.Major, minor: v.Minor, patch: v.Patch, n: 3}
+
+	if len(v.Prerelease) == 0 {
+		if v.Patch == 0 && v.Minor < goLangMinor {
+			g.n = 2
+		}
+
+		return g, nil
+	}
+
+	if v.Patch != 0 {
+		return nil, fmt.Errorf("%w: %q is a pre-release of a patch version", ErrInvalidGoVersion, v)
+	}
+
+	g.n = 2
+	p := v.Prerelease
+
+	if len(p) == 1 && isZeroIdentifier(p[0]) && v.Minor >= goLangMinor {
+		return g, nil
+	}
+
+	if !p[0].IsAlphanumeric() || strings.IndexFunc(p[0].String(), func(r rune) bool { return r < 'a' || r > 'z' }) >= 0 {
+		return nil, fmt.Errorf("%w: %q has an invalid pre-release kind", ErrInvalidGoVersion, v)
+	}
+
+	g.kind = p[0].String()
+
+	switch {
+	case len(p) == 1:
+	case len(p) == 2 && p[1].IsNumeric() && identifierOf(p[1]).s == "": //nolint:mnd // the kind and the number
+		g.pre, g.hasPre = p[1].Uint64(), true
+	default:
+		return nil, fmt.Errorf("%w: %q has an invalid pre-release number", ErrInvalidGoVersion, v)
+	}
+
+	return g, nil
+}
+
+// Compare returns
+//
+//	-1 if v is less than w,
+//	 0 if v equals w,
+//	+1 if v is greater than w.
+//
+// The comparison is done according to the ordering of Go toolchain versions.
+func (v *GoVersion) Compare(w *GoVersion) int {
+	if c := cmp.Compare(v.major, w.major); c != 0 {
+		return c
+	}
+
+	if c := cmp.Compare(v.minor, w.minor); c != 0 {
+		return c
+	}
+
+	// A version without a patch version, like "1.21" or "1.21rc1", is less
+	// than the versions with one.
+	if vp, wp := v.hasPatch(), w.hasPatch(); vp != wp {
+		if vp {
+			return 1
+		}
+
+		return -1
+	}
+
+	if c := cmp.Compare(v.patch, w.patch); c != 0 {
+		return c
+	}
+
+	if c := strings.Compare(v.kind, w.kind); c != 0 {
+		return c
+	}
+
+	if v.hasPre != w.hasPre {
+		if v.hasPre {
+			return 1
+		}
+
+		return -1
+	}
+
+	return cmp.Compare(v.pre, w.pre)
+}
+
+// IsLang reports whether v is a Go language version, like "go1.21", and not
+// a specific release. The language versions before Go 1.21, like "go1.20",
+// are the same as the first release of the minor version, so IsLang reports
+// false for them.
+func (v *GoVersion) IsLang() bool {
+	return !v.hasPatch() && v.kind == ""
+}
+
+// Lang returns the Go language version of v. For example, it returns "go1.21"
+// for "go1.21.3" and "go1.21rc1".
+func (v *GoVersion) Lang() *GoVersion {
+	w := &GoVersion{major: v.major, minor: v.minor, patch: 0, n: 2, kind: "", pre: 0, hasPre: false}
+	if v.major == 1 && v.minor == 0 {
+		w.n = 1
+	}
+
+	return w
+}
+
+// String returns the string representation of v with the "go" prefix, like
+// "go1.21rc2".
+func (v *GoVersion) String() string {
+	b := fmt.Appendf(nil, "go%d", v.major)
+
+	if v.n > 1 {
+		b = fmt.Appendf(b, ".%d", v.minor)
+	}
+
+	if v.n > 2 { //nolint:mnd // <major>.<minor>
+		b = fmt.Appendf(b, ".%d", v.patch)
+	}
+
+	b = append(b, v.kind...)
+
+	if v.hasPre {
+		b = fmt.Appendf(b, "%d", v.pre)
+	}
+
+	return string(b)
+}
+
+// Version converts v into a Version that has the same ordering among
+// the converted Go versions. The language version "go1.21" is converted into
+// "1.21.0-0", which is less than the pre-releases of Go 1.21, and
+// the pre-release "go1.21rc1" into "1.21.0-rc.1". The releases are converted
+// into the same version, so both "go1.20" and "go1.20.0" are converted into
+// "1.20.0".
+func (v *GoVersion) Version() *Version {
+	w := &Version{Major: v.major, Minor: v.minor, Patch: v.patch}
+
+	switch {
+	case v.kind != "":
+		w.Prerelease = Prerelease{alphanumericIdentifier(v.kind)}
+		if v.hasPre {
+			w.Prerelease = append(w.Prerelease, NumericIdentifier(v.pre))
+		}
+	case !v.hasPatch():
+		w.Prerelease = Prerelease{NumericIdentifier(0)}
+	}
+
+	return w
+}
+
+// hasPatch reports whether v has a patch version. Only the language versions
+// since Go 1.21, like "1.21", and the pre-releases, like "1.21rc1", have no
+// patch version.
+func (v *GoVersion) hasPatch() bool {
+	return v.n != 2 || (v.kind == "" && v.minor < goLangMinor) //nolint:mnd // <major>.<minor>
+}
+
+// cutGoNumber parses the number at the start of x in the Go version string s
+// and returns it and the rest of x.
+func cutGoNumber(s, x, name string) (uint64, string, error) {
+	i := 0
+	for i < len(x) && isDigit(x[i]) {
+		i++
+	}
+
+	switch {
+	case i == 0:
+		return 0, "", fmt.Errorf("%w: missing %s version number in %q", ErrInvalidGoVersion, name, s)
+	case x[0] == '0' && i > 1:
+		return 0, "", fmt.Errorf("%w: leading zero in the %s version number in %q", ErrInvalidGoVersion, name, s)
+	}
+
+	u, ok := parseUint(x[:i])
+	if !ok {
+		return 0, "", fmt.Errorf("%w: the %s version number overflows in %q", ErrInvalidGoVersion, name, s)
+	}
+
+	return u, x[i:], nil
+}
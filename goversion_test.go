@@ -0,0 +1,295 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"go/version"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+// goVersions are Go toolchain versions in increasing order. The versions on
+// the same line are equal.
+var goVersions = [][]string{
+	{"go1", "go1.0", "go1.0.0"},
+	{"go1.0.1"},
+	{"go1.2rc1"},
+	{"go1.2", "go1.2.0"},
+	{"go1.9.7"},
+	{"go1.20rc1"},
+	{"go1.20", "go1.20.0"},
+	{"go1.20.14"},
+	{"go1.21"},
+	{"go1.21alpha1"},
+	{"go1.21beta1"},
+	{"go1.21beta2"},
+	{"go1.21rc"},
+	{"go1.21rc0"},
+	{"go1.21rc1"},
+	{"go1.21rc2"},
+	{"go1.21rc10"},
+	{"go1.21.0"},
+	{"go1.21.1"},
+	{"go1.21.10"},
+	{"go1.22"},
+	{"go1.22rc1"},
+	{"go1.22.0", "go1.22.0-custom"},
+	{"go1.100"},
+	{"go2", "go2.0", "go2.0.0"},
+}
+
+func TestGoVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		s     string
+		group int
+	}
+
+	var items []item
+
+	for i, group := range goVersions {
+		for _, s := range group {
+			items = append(items, item{s, i})
+		}
+	}
+
+	for _, a := range items {
+		v := semver.MustParseGoVersion(a.s)
+
+		for _, b := range items {
+			w := semver.MustParseGoVersion(b.s)
+			want := version.Compare(a.s, b.s)
+
+			if got := v.Compare(w); got != want || got != cmpInt(a.group, b.group) {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a.s, b.s, got, want)
+			}
+
+			if got := v.Version().Compare(w.Version()); got != want {
+				t.Errorf("Compare(%q, %q) = %d for the converted versions %q and %q, want %d", a.s, b.s, got, v.Version(), w.Version(), want)
+			}
+		}
+
+		if got, want := v.IsLang(), slices.Contains([]string{"go1.21", "go1.22", "go1.100"}, a.s); got != want {
+			t.Errorf("GoVersion(%q).IsLang() = %v, want %v", a.s, got, want)
+		}
+
+		if got, want := v.Lang().String(), version.Lang(a.s); got != want {
+			t.Errorf("GoVersion(%q).Lang() = %q, want %q", a.s, got, want)
+		}
+	}
+}
+
+func TestGoVersionSort(t *testing.T) {
+	t.Parallel()
+
+	var want []string
+
+	for _, group := range goVersions {
+		want = append(want, group[0])
+	}
+
+	shuffled := slices.Clone(want)
+	rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	versions := make(semver.Versions, len(shuffled))
+	for i, s := range shuffled {
+		versions[i] = semver.MustParseGoVersion(s).Version()
+	}
+
+	versions.Sort()
+
+	for i, v := range versions {
+		g, err := v.GoVersion()
+		if err != nil {
+			t.Fatalf("Version(%q).GoVersion() error = %v", v, err)
+		}
+
+		if g.Compare(semver.MustParseGoVersion(want[i])) != 0 {
+			t.Errorf("sorted Go version %d = %q, want %q", i, g, want[i])
+		}
+	}
+}
+
+func TestParseGoVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    string
+		version string
+	}{
+		{"go1.21.0", "go1.21.0", "1.21.0"},
+		{"1.21.0", "go1.21.0", "1.21.0"},
+		{"go1.21", "go1.21", "1.21.0-0"},
+		{"go1.21rc2", "go1.21rc2", "1.21.0-rc.2"},
+		{"go1.21beta1", "go1.21beta1", "1.21.0-beta.1"},
+		{"go1.21rc", "go1.21rc", "1.21.0-rc"},
+		{"go1.20", "go1.20", "1.20.0"},
+		{"go1.20rc1", "go1.20rc1", "1.20.0-rc.1"},
+		{"go1", "go1", "1.0.0"},
+		{"go1.22.0-bigcorp", "go1.22.0", "1.22.0"},
+		{"go1.23.1 X:rangefunc", "go1.23.1", "1.23.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := semver.ParseGoVersion(tt.in)
+			if err != nil {
+				t.Fatalf("ParseGoVersion(%q) error = %v", tt.in, err)
+			}
+
+			if got := v.String(); got != tt.want {
+				t.Errorf("ParseGoVersion(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+
+			if got := v.Version().String(); got != tt.version {
+				t.Errorf("ParseGoVersion(%q).Version() = %q, want %q", tt.in, got, tt.version)
+			}
+		})
+	}
+}
+
+func TestParseGoVersionError(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"",
+		"go",
+		"gox",
+		"go1.",
+		"go1.21.",
+		"go01.21",
+		"go1.021",
+		"go1.21.00",
+		"go1rc1",
+		"go1.21.0rc1",
+		"go1.21RC1",
+		"go1.21rc01",
+		"go1.21rc1x",
+		"go1.21.0.1",
+		"go1.18446744073709551616",
+		"devel go1.23-abcdef",
+	}
+
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			t.Parallel()
+
+			if version.IsValid(s) && s != "go1.18446744073709551616" {
+				t.Fatalf("test case %q is valid according to go/version", s)
+			}
+
+			v, err := semver.ParseGoVersion(s)
+			if !errors.Is(err, semver.ErrInvalidGoVersion) {
+				t.Errorf("ParseGoVersion(%q) = %v, %v, want error %v", s, v, err, semver.ErrInvalidGoVersion)
+			}
+		})
+	}
+}
+
+func TestVersionGoVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"1.21.0", "go1.21.0"},
+		{"1.21.3", "go1.21.3"},
+		{"1.21.0-0", "go1.21"},
+		{"1.21.0-rc.1", "go1.21rc1"},
+		{"1.21.0-rc", "go1.21rc"},
+		{"1.21.0-beta.2", "go1.21beta2"},
+		{"1.20.0", "go1.20"},
+		{"1.20.1", "go1.20.1"},
+		{"1.20.0-rc.1", "go1.20rc1"},
+		{"1.0.0", "go1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt.in)
+
+			g, err := v.GoVersion()
+			if err != nil {
+				t.Fatalf("Version(%q).GoVersion() error = %v", tt.in, err)
+			}
+
+			if got := g.String(); got != tt.want {
+				t.Errorf("Version(%q).GoVersion() = %q, want %q", tt.in, got, tt.want)
+			}
+
+			if got := g.Version(); !got.Equal(v) {
+				t.Errorf("Version(%q).GoVersion().Version() = %q, want %q", tt.in, got, v)
+			}
+		})
+	}
+}
+
+func TestVersionGoVersionError(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"1.21.0+build",
+		"1.21.1-rc.1",
+		"1.20.0-0",
+		"1.21.0-1",
+		"1.21.0-RC.1",
+		"1.21.0-rc1",
+		"1.21.0-rc.1.2",
+		"1.21.0-rc.x",
+	}
+
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := semver.MustParse(s).GoVersion()
+			if !errors.Is(err, semver.ErrInvalidGoVersion) {
+				t.Errorf("Version(%q).GoVersion() = %v, %v, want error %v", s, g, err, semver.ErrInvalidGoVersion)
+			}
+		})
+	}
+
+	g, err := semver.MustParseBig("1.99999999999999999999.0").GoVersion()
+	if !errors.Is(err, semver.ErrInvalidGoVersion) {
+		t.Errorf("GoVersion() for a big version = %v, %v, want error %v", g, err, semver.ErrInvalidGoVersion)
+	}
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
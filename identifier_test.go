@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestNewPrerelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a       []any
+		want    string
+		wantErr bool
+	}{
+		{"strings", []any{"alpha", "1"}, "alpha.1", false},
+		{"ints", []any{"rc", 2, uint64(3)}, "rc.2.3", false},
+		{"identifiers", []any{semver.NumericIdentifier(7), "x"}, "7.x", false},
+		{"empty", nil, "", false},
+		{"negative", []any{-1}, "", true},
+		{"leading-zero", []any{"01"}, "", true},
+		{"invalid-character", []any{"a_b"}, "", true},
+		{"non-ascii", []any{"é"}, "", true},
+		{"invalid-type", []any{1.5}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.NewPrerelease(tt.a...)
+			if tt.wantErr {
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Errorf("NewPrerelease(%v) = %v, %v, want ErrInvalidVersion", tt.a, got, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewPrerelease(%v) failed unexpectedly: %v", tt.a, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("NewPrerelease(%v) = %q, want %q", tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVersionFromConstructors(t *testing.T) {
+	t.Parallel()
+
+	pre, err := semver.NewPrerelease("beta", 2)
+	if err != nil {
+		t.Fatalf("NewPrerelease() failed unexpectedly: %v", err)
+	}
+
+	build, err := semver.NewBuild("darwin", "amd64")
+	if err != nil {
+		t.Fatalf("NewBuild() failed unexpectedly: %v", err)
+	}
+
+	v := &semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: pre, Build: build}
+	want := semver.MustParse("1.2.3-beta.2+darwin.amd64")
+
+	if !v.StrictEqual(want) {
+		t.Errorf("Version = %v, want %v", v, want)
+	}
+
+	if v.Compare(semver.MustParse("1.2.3-beta.10")) != -1 {
+		t.Errorf("Version{%q}.Compare(%q) != -1", v, "1.2.3-beta.10")
+	}
+
+	empty, err := semver.NewPrerelease()
+	if err != nil {
+		t.Fatalf("NewPrerelease() failed unexpectedly: %v", err)
+	}
+
+	r := &semver.Version{Major: 1, Prerelease: empty}
+	if r.Compare(semver.MustParse("1.0.0")) != 0 {
+		t.Errorf("Version with empty Prerelease does not equal %q", "1.0.0")
+	}
+}
+
+func TestNewBuild(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       []string
+		wantErr bool
+	}{
+		{[]string{"sha", "5114f85"}, false},
+		{[]string{"001"}, false},
+		{nil, false},
+		{[]string{""}, true},
+		{[]string{"a.b"}, true},
+		{[]string{"a+b"}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := semver.NewBuild(tt.s...)
+		if tt.wantErr != (err != nil) {
+			t.Errorf("NewBuild(%q) = %v, %v, want error: %v", tt.s, got, err, tt.wantErr)
+		}
+
+		if err != nil && !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("NewBuild(%q) error = %v, want ErrInvalidVersion", tt.s, err)
+		}
+	}
+}
+
+func TestIdentifierConstructors(t *testing.T) {
+	t.Parallel()
+
+	n := semver.NumericIdentifier(42)
+	if !n.IsNumeric() || n.IsAlphanumeric() || n.Uint64() != 42 || n.Len() != 2 ||
+		n.String() != "42" {
+		t.Errorf("NumericIdentifier(42) = %v, has wrong accessors", n)
+	}
+
+	a, err := semver.AlphanumericIdentifier("rc-1")
+	if err != nil {
+		t.Fatalf("AlphanumericIdentifier(%q) failed unexpectedly: %v", "rc-1", err)
+	}
+
+	if a.IsNumeric() || !a.IsAlphanumeric() || a.Uint64() != 0 || a.Len() != 4 ||
+		a.String() != "rc-1" {
+		t.Errorf("AlphanumericIdentifier(%q) = %v, has wrong accessors", "rc-1", a)
+	}
+
+	for _, s := range []string{"", "12", "a.b", "a_b"} {
+		if got, err := semver.AlphanumericIdentifier(s); !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("AlphanumericIdentifier(%q) = %v, %v, want ErrInvalidVersion", s, got, err)
+		}
+	}
+}
+
+func TestIdentifierInterface(t *testing.T) {
+	t.Parallel()
+
+	var zero semver.PrereleaseIdentifier
+	if zero != nil {
+		t.Errorf("zero PrereleaseIdentifier = %v, want nil", zero)
+	}
+
+	v := semver.MustParseBig("1.0.0-beta.18446744073709551616.7")
+
+	for i, want := range []string{"beta", "18446744073709551616", "7"} {
+		var got string
+
+		switch id := v.Prerelease[i].(type) {
+		case nil:
+			t.Fatalf("Prerelease[%d] of %v is nil", i, v)
+		case fmt.Stringer:
+			got = id.String()
+		}
+
+		if got != want {
+			t.Errorf("Prerelease[%d] of %v = %q, want %q", i, v, got, want)
+		}
+	}
+
+	if _, ok := v.Prerelease[0].(interface{ IsAlphanumeric() bool }); !ok {
+		t.Errorf("Prerelease[0] of %v does not implement IsAlphanumeric", v)
+	}
+}
+
+func TestIdentifierCompareLong(t *testing.T) {
+	t.Parallel()
+
+	ids := []string{
+		"-", "0a", "A", "Z", "a", "a-", "alpha", "alphab", "alphabet", "alphabet-",
+		"alphabet0", "alphabeta", "alphabetaa", "alphabetb", "b", "beta", "z",
+	}
+
+	for i, a := range ids {
+		for j, b := range ids {
+			v := semver.MustParse("1.0.0-" + a)
+			w := semver.MustParse("1.0.0-" + b)
+
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+
+			if got := v.Compare(w); got != want {
+				t.Errorf("Version{%q}.Compare(%q) = %d, want %d", v, w, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+// A Key is a comparable value that identifies a [Version] up to its build
+// metadata. Two Keys are equal if and only if the Versions that they were
+// created from are equal according to [Version.Equal], so Keys can be used as
+// map keys, for example in a sync.Map, to deduplicate and index versions.
+// The zero Key is the key of version 0.0.0.
+type Key struct {
+	major      uint64
+	minor      uint64
+	patch      uint64
+	big        [3]string
+	prerelease string
+}
+
+// A StrictKey is a comparable value that identifies a [Version] including its
+// build metadata. Two StrictKeys are equal if and only if the Versions that
+// they were created from are equal according to [Version.StrictEqual].
+type StrictKey struct {
+	Key
+
+	build string
+}
+
+// Key returns the comparable key of v that matches [Version.Equal].
+func (v *Version) Key() Key {
+	return Key{
+		major:      v.Major,
+		minor:      v.Minor,
+		patch:      v.Patch,
+		big:        v.bigDigits(),
+		prerelease: v.Prerelease.String(),
+	}
+}
+
+// StrictKey returns the comparable key of v that matches
+// [Version.StrictEqual].
+func (v *Version) StrictKey() StrictKey {
+	return StrictKey{Key: v.Key(), build: v.Build.String()}
+}
+
+// String returns the string representation of the version that k identifies
+// without the build metadata, as returned by [Version.ComparableString].
+func (k Key) String() string {
+	return string(k.appendText(nil))
+}
+
+// String returns the string representation of the version that k identifies,
+// as returned by [Version.String].
+func (k StrictKey) String() string {
+	b := k.appendText(nil)
+
+	if k.build != "" {
+		b = append(b, '+')
+		b = append(b, k.build...)
+	}
+
+	return string(b)
+}
+
+// appendText appends the string representation of k to b.
+func (k Key) appendText(b []byte) []byte {
+	b = appendNumber(b, k.major, k.big[0])
+	b = append(b, '.')
+	b = appendNumber(b, k.minor, k.big[1])
+	b = append(b, '.')
+	b = appendNumber(b, k.patch, k.big[2])
+
+	if k.prerelease != "" {
+		b = append(b, '-')
+		b = append(b, k.prerelease...)
+	}
+
+	return b
+}
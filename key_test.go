@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.2.3", "v1.2.3"},
+		{"1.2.3", "1.2.4"},
+		{"1.2.3", "1.2.3-0"},
+		{"1.2.3-rc.1", "1.2.3-rc.1"},
+		{"1.2.3-rc.1", "1.2.3-rc-1"},
+		{"1.2.3-rc.1", "1.2.3-rc.1.0"},
+		{"1.2.3-1", "1.2.3-01a"},
+		{"1.2.3+a", "1.2.3+b"},
+		{"1.2.3+a.b", "1.2.3+a.b"},
+		{"1.2.3+a.b", "1.2.3+a-b"},
+		{"1.2.3-a.b+c", "1.2.3-a+b.c"},
+		{"18446744073709551615.0.0", "18446744073709551616.0.0"},
+		{"18446744073709551616.0.0", "18446744073709551616.0.0"},
+		{"1.0.0-18446744073709551615", "1.0.0-18446744073709551616"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			a := semver.MustParseBig(tt.a)
+			b := semver.MustParseBig(tt.b)
+
+			if got, want := a.Key() == b.Key(), a.Equal(b); got != want {
+				t.Errorf("Key(%q) == Key(%q) is %t, want %t", tt.a, tt.b, got, want)
+			}
+
+			if got, want := a.StrictKey() == b.StrictKey(), a.StrictEqual(b); got != want {
+				t.Errorf("StrictKey(%q) == StrictKey(%q) is %t, want %t", tt.a, tt.b, got, want)
+			}
+
+			if got, want := a.Key().String(), a.ComparableString(); got != want {
+				t.Errorf("Key(%q).String() = %q, want %q", tt.a, got, want)
+			}
+
+			if got, want := a.StrictKey().String(), a.String(); got != want {
+				t.Errorf("StrictKey(%q).String() = %q, want %q", tt.a, got, want)
+			}
+		})
+	}
+}
+
+func TestVersionKeyMap(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[semver.Key]int)
+
+	for _, s := range []string{"1.0.0", "v1.0.0", "1.0.0+build", "1.0.0-rc.1", "2.0.0"} {
+		seen[semver.MustParse(s).Key()]++
+	}
+
+	if len(seen) != 3 || seen[semver.MustParse("1.0.0").Key()] != 3 {
+		t.Errorf("map of Keys = %v, want 3 keys with 3 for 1.0.0", seen)
+	}
+
+	var m sync.Map
+
+	m.Store(semver.MustParse("1.0.0+a").StrictKey(), 1)
+
+	if _, ok := m.Load(semver.MustParse("v1.0.0+a").StrictKey()); !ok {
+		t.Error("sync.Map.Load(StrictKey(1.0.0+a)) did not find the stored value")
+	}
+
+	if _, ok := m.Load(semver.MustParse("1.0.0+b").StrictKey()); ok {
+		t.Error("sync.Map.Load(StrictKey(1.0.0+b)) found a value for a different build")
+	}
+}
+
+func TestVersionKeyAllocs(t *testing.T) {
+	for _, s := range []string{"1.2.3", "1.2.3-rc+build"} {
+		v := semver.MustParse(s)
+
+		if n := testing.AllocsPerRun(100, func() { _ = v.StrictKey() }); n != 0 {
+			t.Errorf("Version{%q}.StrictKey() allocated %v times, want 0", s, n)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+// Original returns the version string that v was parsed from. The original
+// string is only stored if v was parsed by a [Parser] with KeepOriginal set.
+// Otherwise Original returns the string representation of v, as returned by
+// [Version.String].
+func (v *Version) Original() string {
+	if v.src == nil {
+		return v.String()
+	}
+
+	return v.src.text
+}
+
+// Prefix returns the prefix of the version string that v was parsed from, like
+// "v". It returns an empty string if the version string had no prefix or if
+// the original string was not stored.
+func (v *Version) Prefix() string {
+	if v.src == nil {
+		return ""
+	}
+
+	return v.src.prefix
+}
+
+// CoreCount returns the number of core version numbers in the version string
+// that v was parsed from. For example, it returns 2 for "v1.2". It returns 3 if
+// the original string was not stored.
+func (v *Version) CoreCount() int {
+	if v.src == nil {
+		return 3 //nolint:mnd // <major>.<minor>.<patch>
+	}
+
+	return v.src.core
+}
+
+// FormatLike returns the string representation of v formatted like
+// the version string that w was parsed from. It uses the prefix and the number
+// of core version numbers of w, as returned by [Version.Prefix] and
+// [Version.CoreCount], so it can be used to write a new version in the same
+// style as the original one:
+//
+//	p := semver.Parser{MinCore: 1, KeepOriginal: true}
+//	v := p.MustParse("v1.2")
+//	next, err := v.IncMinor()
+//	s := next.FormatLike(v) // "v1.3"
+//
+// The core version numbers that are not zero are always included, so
+// the result may have more numbers than w. If w has more than three core
+// version numbers, the leading numeric build identifiers of v are written as
+// the extra core version numbers.
+func (v *Version) FormatLike(w *Version) string {
+	d := v.bigDigits()
+	core := w.CoreCount()
+
+	switch {
+	case v.Patch != 0 || d[2] != "":
+		core = max(core, 3) //nolint:mnd // <major>.<minor>.<patch>
+	case v.Minor != 0 || d[1] != "":
+		core = max(core, 2) //nolint:mnd // <major>.<minor>
+	}
+
+	build := v.Build
+
+	b := []byte(w.Prefix())
+	b = appendNumber(b, v.Major, d[0])
+
+	if core > 1 {
+		b = append(b, '.')
+		b = appendNumber(b, v.Minor, d[1])
+	}
+
+	if core > 2 { //nolint:mnd // <major>.<minor>
+		b = append(b, '.')
+		b = appendNumber(b, v.Patch, d[2])
+	}
+
+	for i := 3; i < core && len(build) > 0 && isNumericIdentifier(build[0]); i++ {
+		b = append(b, '.')
+		b = append(b, build[0]...)
+		build = build[1:]
+	}
+
+	if len(v.Prerelease) > 0 {
+		b = append(b, '-')
+		b, _ = v.Prerelease.AppendText(b)
+	}
+
+	if len(build) > 0 {
+		b = append(b, '+')
+		b, _ = build.AppendText(b)
+	}
+
+	return string(b)
+}
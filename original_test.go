@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestVersionOriginal(t *testing.T) {
+	t.Parallel()
+
+	p := semver.Parser{
+		Prefixes:          []string{"v", "V", "release-"},
+		MinCore:           1,
+		MaxCore:           4,
+		TrimSpace:         true,
+		AllowLeadingZeros: true,
+		KeepOriginal:      true,
+	}
+
+	tests := []struct {
+		input  string
+		prefix string
+		core   int
+		want   string
+	}{
+		{"1.2.3", "", 3, "1.2.3"},
+		{"v1.2", "v", 2, "1.2.0"},
+		{"V1", "V", 1, "1.0.0"},
+		{"release-1.2.3-rc.1+b", "release-", 3, "1.2.3-rc.1+b"},
+		{" v01.2.3.4 ", "v", 4, "1.2.3+4"},
+		{"1.2-beta", "", 2, "1.2.0-beta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			v := p.MustParse(tt.input)
+
+			if got := v.Original(); got != tt.input {
+				t.Errorf("Version.Original() = %q, want %q", got, tt.input)
+			}
+
+			if got := v.Prefix(); got != tt.prefix {
+				t.Errorf("Version.Prefix() = %q, want %q", got, tt.prefix)
+			}
+
+			if got := v.CoreCount(); got != tt.core {
+				t.Errorf("Version.CoreCount() = %d, want %d", got, tt.core)
+			}
+
+			if got := v.String(); got != tt.want {
+				t.Errorf("Version.String() = %q, want %q", got, tt.want)
+			}
+
+			w := semver.MustParseLax(tt.want)
+			if !v.StrictEqual(w) || v.Compare(w) != 0 || v.StrictKey() != w.StrictKey() {
+				t.Errorf("Version{%q} is not equal to %q", tt.input, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionOriginalNotKept(t *testing.T) {
+	t.Parallel()
+
+	v := semver.MustParseLax("v1.2")
+
+	if got := v.Original(); got != "1.2.0" {
+		t.Errorf("Version.Original() = %q, want %q", got, "1.2.0")
+	}
+
+	if got, want := v.Prefix(), ""; got != want {
+		t.Errorf("Version.Prefix() = %q, want %q", got, want)
+	}
+
+	if got, want := v.CoreCount(), 3; got != want {
+		t.Errorf("Version.CoreCount() = %d, want %d", got, want)
+	}
+
+	p := semver.Parser{MinCore: 1, KeepOriginal: true}
+
+	w := p.MustParse("v1.2")
+	if err := w.ParseInto("1.2.3"); err != nil {
+		t.Fatalf("Version.ParseInto() failed unexpectedly: %v", err)
+	}
+
+	if got := w.Original(); got != "1.2.3" {
+		t.Errorf("Version.Original() after ParseInto = %q, want %q", got, "1.2.3")
+	}
+}
+
+func TestVersionFormatLike(t *testing.T) {
+	t.Parallel()
+
+	p := semver.Parser{MinCore: 1, MaxCore: 4, Prefixes: []string{"v", "="}, KeepOriginal: true}
+
+	tests := []struct {
+		like string
+		v    string
+		want string
+	}{
+		{"v1.2", "1.3.0", "v1.3"},
+		{"v1.2", "1.2.1", "v1.2.1"},
+		{"v1", "2.0.0", "v2"},
+		{"v1", "2.1.0-rc.1", "v2.1-rc.1"},
+		{"=1.2.3", "1.2.4", "=1.2.4"},
+		{"1.2.3.4", "1.2.3+5.build", "1.2.3.5+build"},
+		{"1.2.3.4", "1.2.4", "1.2.4"},
+		{"1.2.3", "1.0.0+b", "1.0.0+b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.like+"_"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			like := p.MustParse(tt.like)
+			v := semver.MustParse(tt.v)
+
+			if got := v.FormatLike(like); got != tt.want {
+				t.Errorf("Version{%q}.FormatLike(%q) = %q, want %q", tt.v, tt.like, got, tt.want)
+			}
+		})
+	}
+}
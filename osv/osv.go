@@ -0,0 +1,278 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+/*
+Package osv evaluates the affected version ranges of vulnerability records in
+the Open Source Vulnerability (OSV) format.
+
+The format is specified at https://ossf.github.io/osv-schema/. The affected
+packages of a record list the affected versions as ranges of events, like
+{"introduced": "1.0.0"} and {"fixed": "1.4.2"}. The types in this package
+decode the "affected[].ranges[]" and "affected[].versions" fields of a record
+using [encoding/json], and [Range.Affects] and [Affected.Affects] report
+whether a version is affected. The ranges of the type "SEMVER" are evaluated
+using the algorithm of the specification, comparing the versions using
+[semver.Version.Compare]:
+
+	var a osv.Affected
+	err := json.Unmarshal(data, &a)
+	ok, err := a.Affects(semver.MustParse("1.3.0"))
+*/
+package osv
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/anttikivi/semver"
+)
+
+// Values for the type of a range.
+const (
+	// TypeSemVer is the type of the ranges whose versions are semantic
+	// versions.
+	TypeSemVer RangeType = "SEMVER"
+
+	// TypeEcosystem is the type of the ranges whose versions use the version
+	// scheme of the ecosystem of the package.
+	TypeEcosystem RangeType = "ECOSYSTEM"
+
+	// TypeGit is the type of the ranges whose versions are Git commit hashes.
+	TypeGit RangeType = "GIT"
+)
+
+// Values for the kind of an event.
+const (
+	introduced eventKind = iota
+	fixed
+	lastAffected
+	limit
+)
+
+// ErrInvalidRange is returned when a range cannot be evaluated because it is
+// not valid or it is not of the type "SEMVER".
+var ErrInvalidRange = errors.New("invalid OSV range")
+
+// A RangeType is the type of a range, like "SEMVER".
+type RangeType string
+
+// An Affected holds the affected versions of a package in an OSV record. It is
+// an element of the "affected" array of the record. The other fields of
+// the element, like "package", are not decoded.
+type Affected struct {
+	// Ranges are the ranges of the affected versions.
+	Ranges []Range `json:"ranges,omitempty"`
+
+	// Versions are the affected versions listed one by one.
+	Versions []string `json:"versions,omitempty"`
+}
+
+// A Range is a range of affected versions in an OSV record. It is an element
+// of the "affected[].ranges" array of the record.
+type Range struct {
+	// Type is the type of the range. Only the ranges of the type "SEMVER" can
+	// be evaluated.
+	Type RangeType `json:"type"`
+
+	// Repo is the URL of the repository for the ranges of the type "GIT".
+	Repo string `json:"repo,omitempty"`
+
+	// Events are the events that introduce and fix the vulnerability.
+	Events []Event `json:"events"`
+}
+
+// An Event is an event in a range. Exactly one of its fields must be set.
+type Event struct {
+	// Introduced is the version that introduced the vulnerability. The special
+	// value "0" means that the vulnerability was introduced before all of
+	// the versions.
+	Introduced string `json:"introduced,omitempty"`
+
+	// Fixed is the version that fixed the vulnerability.
+	Fixed string `json:"fixed,omitempty"`
+
+	// LastAffected is the last version that is affected by the vulnerability.
+	LastAffected string `json:"last_affected,omitempty"`
+
+	// Limit is the upper limit of the range. The versions that are not less
+	// than any of the limits of a range are not affected. The special value "*"
+	// means that there is no limit.
+	Limit string `json:"limit,omitempty"`
+}
+
+// An eventKind is the kind of an event.
+type eventKind int
+
+// An event is a parsed Event. Its version is nil for the introduced event "0"
+// and the limit "*".
+type event struct {
+	kind    eventKind
+	version *semver.Version
+}
+
+// Affects reports whether v is affected according to a. The version is
+// affected if any of the ranges of the type "SEMVER" affects it or if it is
+// equal to any of the listed versions. The other ranges and the listed versions
+// that are not semantic versions are ignored. Affects returns an error if any
+// of the "SEMVER" ranges is not valid.
+func (a *Affected) Affects(v *semver.Version) (bool, error) {
+	for i := range a.Ranges {
+		if a.Ranges[i].Type != TypeSemVer {
+			continue
+		}
+
+		ok, err := a.Ranges[i].Affects(v)
+		if err != nil {
+			return false, fmt.Errorf("failed to evaluate range %d: %w", i, err)
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	for _, s := range a.Versions {
+		if w, err := semver.Parse(s); err == nil && v.Equal(w) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Affects reports whether v is in r. It evaluates the events using
+// the algorithm of the OSV specification: if v is not less than any of
+// the limits, it is not affected. Otherwise the events are sorted by their
+// versions, with the introduced event "0" first, and v is affected if
+// the last event that applies to it is an introduced event. An introduced event
+// applies to the versions that are greater than or equal to its version,
+// a fixed event to the versions that are greater than or equal to its version,
+// and a last-affected event to the versions that are greater than its version.
+//
+// Affects returns an error that wraps [ErrInvalidRange] if r is not of
+// the type "SEMVER", if an event does not have exactly one field set, or if
+// a version in the events is not a valid semantic version.
+func (r *Range) Affects(v *semver.Version) (bool, error) {
+	if r.Type != TypeSemVer {
+		return false, fmt.Errorf("%w: cannot evaluate range of type %q", ErrInvalidRange, r.Type)
+	}
+
+	events := make([]event, 0, len(r.Events))
+	hasLimit := false
+	belowLimit := false
+
+	for i, e := range r.Events {
+		p, err := parseEvent(e)
+		if err != nil {
+			return false, fmt.Errorf("invalid event %d: %w", i, err)
+		}
+
+		if p.kind == limit {
+			hasLimit = true
+			belowLimit = belowLimit || p.version == nil || v.Compare(p.version) < 0
+
+			continue
+		}
+
+		events = append(events, p)
+	}
+
+	if hasLimit && !belowLimit {
+		return false, nil
+	}
+
+	slices.SortStableFunc(events, func(a, b event) int {
+		switch {
+		case a.version == nil && b.version == nil:
+			return 0
+		case a.version == nil:
+			return -1
+		case b.version == nil:
+			return 1
+		default:
+			return a.version.Compare(b.version)
+		}
+	})
+
+	affected := false
+
+	for _, e := range events {
+		switch e.kind {
+		case introduced:
+			if e.version == nil || v.Compare(e.version) >= 0 {
+				affected = true
+			}
+		case fixed:
+			if v.Compare(e.version) >= 0 {
+				affected = false
+			}
+		case lastAffected:
+			if v.Compare(e.version) > 0 {
+				affected = false
+			}
+		case limit:
+		}
+	}
+
+	return affected, nil
+}
+
+// parseEvent parses the version of e.
+func parseEvent(e Event) (event, error) {
+	fields := [...]struct {
+		kind  eventKind
+		value string
+	}{
+		{introduced, e.Introduced},
+		{fixed, e.Fixed},
+		{lastAffected, e.LastAffected},
+		{limit, e.Limit},
+	}
+
+	var (
+		p     event
+		s     string
+		count int
+	)
+
+	for _, f := range fields {
+		if f.value != "" {
+			p.kind, s = f.kind, f.value
+			count++
+		}
+	}
+
+	if count != 1 {
+		return event{}, fmt.Errorf("%w: event must have exactly one field set, got %d", ErrInvalidRange, count)
+	}
+
+	if (p.kind == introduced && s == "0") || (p.kind == limit && s == "*") {
+		return p, nil
+	}
+
+	v, err := semver.Parse(s)
+	if err != nil {
+		return event{}, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+
+	p.version = v
+
+	return p, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package osv_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/osv"
+)
+
+func TestRangeAffects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		events   []osv.Event
+		affected []string
+		safe     []string
+	}{
+		{
+			"introduced zero",
+			[]osv.Event{{Introduced: "0"}},
+			[]string{"0.0.0", "0.0.0-0", "1.0.0", "99.0.0"},
+			nil,
+		},
+		{
+			"introduced zero and fixed",
+			[]osv.Event{{Introduced: "0"}, {Fixed: "1.4.2"}},
+			[]string{"0.0.0-alpha", "1.0.0", "1.4.2-rc.1", "1.4.1"},
+			[]string{"1.4.2", "1.4.2+build", "2.0.0"},
+		},
+		{
+			"introduced and fixed",
+			[]osv.Event{{Introduced: "1.0.0"}, {Fixed: "1.4.2"}},
+			[]string{"1.0.0", "1.2.0"},
+			[]string{"0.9.0", "1.0.0-rc.1", "1.4.2", "1.5.0"},
+		},
+		{
+			"last affected",
+			[]osv.Event{{Introduced: "1.0.0"}, {LastAffected: "1.4.2"}},
+			[]string{"1.0.0", "1.4.2", "1.4.2+build"},
+			[]string{"0.9.0", "1.4.3", "1.4.3-0"},
+		},
+		{
+			"multiple intervals",
+			[]osv.Event{
+				{Introduced: "0"},
+				{Fixed: "1.2.3"},
+				{Introduced: "2.0.0"},
+				{Fixed: "2.1.1"},
+				{Introduced: "3.0.0"},
+			},
+			[]string{"1.0.0", "2.0.0", "2.1.0", "3.0.0", "4.0.0"},
+			[]string{"1.2.3", "1.9.0", "2.1.1", "2.9.0", "3.0.0-rc.1"},
+		},
+		{
+			"unsorted events",
+			[]osv.Event{
+				{Fixed: "2.1.1"},
+				{Introduced: "3.0.0"},
+				{Introduced: "2.0.0"},
+				{Fixed: "1.2.3"},
+				{Introduced: "0"},
+			},
+			[]string{"1.0.0", "2.0.0", "3.0.0"},
+			[]string{"1.2.3", "2.1.1"},
+		},
+		{
+			"limit",
+			[]osv.Event{{Introduced: "0"}, {Limit: "2.0.0"}},
+			[]string{"1.0.0", "2.0.0-rc.1"},
+			[]string{"2.0.0", "3.0.0"},
+		},
+		{
+			"multiple limits",
+			[]osv.Event{{Introduced: "1.0.0"}, {Limit: "2.0.0"}, {Limit: "3.0.0"}},
+			[]string{"1.0.0", "2.5.0"},
+			[]string{"0.1.0", "3.0.0"},
+		},
+		{
+			"limit infinity",
+			[]osv.Event{{Introduced: "1.0.0"}, {Limit: "*"}},
+			[]string{"1.0.0", "100.0.0"},
+			[]string{"0.1.0"},
+		},
+		{
+			"introduced and fixed at the same version",
+			[]osv.Event{{Introduced: "1.0.0"}, {Fixed: "1.0.0"}},
+			nil,
+			[]string{"0.1.0", "1.0.0", "2.0.0"},
+		},
+		{
+			"fixed and introduced at the same version",
+			[]osv.Event{{Fixed: "1.0.0"}, {Introduced: "1.0.0"}},
+			[]string{"1.0.0", "2.0.0"},
+			[]string{"0.1.0"},
+		},
+		{
+			"only fixed",
+			[]osv.Event{{Fixed: "1.0.0"}},
+			nil,
+			[]string{"0.1.0", "1.0.0", "2.0.0"},
+		},
+		{"no events", nil, nil, []string{"1.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := osv.Range{Type: osv.TypeSemVer, Events: tt.events}
+
+			for _, s := range tt.affected {
+				ok, err := r.Affects(semver.MustParse(s))
+				if err != nil || !ok {
+					t.Errorf("Range.Affects(%q) = %v, %v, want true", s, ok, err)
+				}
+			}
+
+			for _, s := range tt.safe {
+				ok, err := r.Affects(semver.MustParse(s))
+				if err != nil || ok {
+					t.Errorf("Range.Affects(%q) = %v, %v, want false", s, ok, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeAffectsError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		r    osv.Range
+	}{
+		{"ecosystem", osv.Range{Type: osv.TypeEcosystem, Events: []osv.Event{{Introduced: "0"}}}},
+		{"git", osv.Range{Type: osv.TypeGit, Repo: "https://example.com/repo", Events: []osv.Event{{Introduced: "0"}}}},
+		{"empty event", osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{}}}},
+		{"two fields", osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{Introduced: "0", Fixed: "1.0.0"}}}},
+		{"invalid version", osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{Introduced: "1.0"}}}},
+		{"fixed zero", osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{Fixed: "0"}}}},
+		{"introduced infinity", osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{Introduced: "*"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := tt.r.Affects(semver.MustParse("1.0.0"))
+			if !errors.Is(err, osv.ErrInvalidRange) || ok {
+				t.Errorf("Range.Affects() = %v, %v, want error %v", ok, err, osv.ErrInvalidRange)
+			}
+		})
+	}
+}
+
+func TestAffected(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{
+		"package": {"ecosystem": "Go", "name": "example.com/mod"},
+		"ranges": [
+			{
+				"type": "GIT",
+				"repo": "https://example.com/mod",
+				"events": [{"introduced": "0"}, {"fixed": "abcdef123456"}]
+			},
+			{
+				"type": "SEMVER",
+				"events": [{"introduced": "0"}, {"fixed": "1.2.3"}, {"introduced": "2.0.0"}, {"last_affected": "2.1.0"}]
+			}
+		],
+		"versions": ["3.0.0-beta.1", "not a version"],
+		"database_specific": {"url": "https://example.com"}
+	}`)
+
+	var a osv.Affected
+	if err := json.Unmarshal(data, &a); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if len(a.Ranges) != 2 || a.Ranges[1].Type != osv.TypeSemVer || len(a.Ranges[1].Events) != 4 ||
+		a.Ranges[1].Events[3].LastAffected != "2.1.0" || a.Ranges[0].Repo != "https://example.com/mod" {
+		t.Fatalf("json.Unmarshal() = %+v", a)
+	}
+
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"0.1.0", true},
+		{"1.2.2", true},
+		{"1.2.3", false},
+		{"2.0.0", true},
+		{"2.1.0", true},
+		{"2.1.1", false},
+		{"3.0.0-beta.1", true},
+		{"3.0.0-beta.1+build", true},
+		{"3.0.0", false},
+	}
+
+	for _, tt := range tests {
+		got, err := a.Affects(semver.MustParse(tt.version))
+		if err != nil || got != tt.want {
+			t.Errorf("Affected.Affects(%q) = %v, %v, want %v", tt.version, got, err, tt.want)
+		}
+	}
+
+	a.Ranges = append(a.Ranges, osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{Fixed: "x"}}})
+
+	if ok, err := a.Affects(semver.MustParse("3.0.0")); !errors.Is(err, osv.ErrInvalidRange) {
+		t.Errorf("Affected.Affects() with an invalid range = %v, %v, want error %v", ok, err, osv.ErrInvalidRange)
+	}
+}
+
+func TestRangeJSON(t *testing.T) {
+	t.Parallel()
+
+	r := osv.Range{Type: osv.TypeSemVer, Events: []osv.Event{{Introduced: "0"}, {Fixed: "1.0.0"}}}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	if want := `{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.0.0"}]}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+}
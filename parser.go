@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// asciiSpace holds the ASCII white space characters.
+const asciiSpace = " \t\n\v\f\r"
+
+// A Parser parses version strings using configurable options. It can be used
+// to accept version strings from ecosystems that do not follow the semantic
+// versioning specification strictly, while the parsed Versions still adhere to
+// the specification. The zero Parser parses version strings like [Parse].
+//
+// For example, the following Parser accepts strings like " V01.2 " and
+// "release-1.2.3.4":
+//
+//	p := semver.Parser{
+//		Prefixes:          []string{"v", "release-"},
+//		MinCore:           1,
+//		MaxCore:           4,
+//		TrimSpace:         true,
+//		AllowLeadingZeros: true,
+//		NormalizeCase:     true,
+//	}
+//	v, err := p.Parse(" V01.2 ")
+type Parser struct {
+	// Prefixes are the prefixes that the version strings may start with.
+	// The longest of the prefixes that the string starts with is removed
+	// before parsing the version. If Prefixes is nil, the prefix "v" is
+	// allowed. To disallow all prefixes, set Prefixes to an empty, non-nil
+	// slice.
+	Prefixes []string
+
+	// MinCore is the minimum number of numbers in the core version. The missing
+	// minor and patch versions are set to zero, so with MinCore set to 1 "1.2"
+	// is parsed as "1.2.0" like [ParseLax] does. If MinCore is zero, three
+	// numbers are required.
+	MinCore int
+
+	// MaxCore is the maximum number of numbers in the core version. As
+	// a Version has only three numbers, the numbers after the patch version
+	// are prepended to the build identifiers, so with MaxCore set to 4
+	// "1.2.3.4" is parsed as "1.2.3+4". If MaxCore is zero, at most three
+	// numbers are allowed.
+	MaxCore int
+
+	// TrimSpace tells the Parser to remove the leading and trailing white space
+	// from the version strings.
+	TrimSpace bool
+
+	// AllowLeadingZeros tells the Parser to accept leading zeros in the core
+	// version numbers and in the numeric pre-release identifiers. The zeros are
+	// removed, so "01.2.3-rc.01" is parsed as "1.2.3-rc.1".
+	AllowLeadingZeros bool
+
+	// NormalizeCase tells the Parser to match the prefixes case-insensitively
+	// and to convert the ASCII letters in the pre-release and build identifiers
+	// to lower case, so "V1.2.3-RC.1" is parsed as "1.2.3-rc.1".
+	NormalizeCase bool
+
+	// AllowBig tells the Parser to accept numbers that do not fit in uint64
+	// like [ParseBig] does.
+	AllowBig bool
+
+	// KeepOriginal tells the Parser to store the original version string,
+	// the prefix, and the number of core version numbers in the parsed
+	// Version. They can be used to reproduce the formatting of the input using
+	// [Version.Original] and [Version.FormatLike].
+	KeepOriginal bool
+}
+
+// Parse parses the given string into a Version using the options of p.
+func (p *Parser) Parse(s string) (*Version, error) {
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+
+	v, err := p.parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return v, nil
+}
+
+// MustParse parses the given string into a Version using the options of p and
+// panics if it encounters an error.
+func (p *Parser) MustParse(s string) *Version {
+	v, err := p.Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a version: %v", s, err))
+	}
+
+	return v
+}
+
+// check returns an error if the options of p are invalid.
+func (p *Parser) check() error {
+	switch {
+	case p.MinCore < 0 || p.MaxCore < 0:
+		return fmt.Errorf("%w: negative number of core version numbers", ErrParser)
+	case p.minCore() > p.maxCore():
+		return fmt.Errorf(
+			"%w: MinCore %d is greater than MaxCore %d",
+			ErrParser,
+			p.minCore(),
+			p.maxCore(),
+		)
+	default:
+		return nil
+	}
+}
+
+// parse parses the version string s into a new Version.
+func (p *Parser) parse(s string) (*Version, error) {
+	var core Version
+
+	t, offset, pos, err := p.parseHead(&core, s)
+	if err != nil {
+		return nil, err
+	}
+
+	v := allocVersion(&core, t[pos:])
+
+	if err := p.parseRest(v, s, t, offset, pos); err != nil {
+		return nil, err
+	}
+
+	v.trimPrerelease()
+
+	return v, nil
+}
+
+// versionBlock holds a Version and the storage for its pre-release
+// identifiers. Allocating them together keeps the identifiers next to
+// the Version in memory, which makes comparing and sorting versions faster.
+type versionBlock struct {
+	v   Version
+	pre [2]PrereleaseIdentifier
+	ids [2]identifier
+}
+
+// allocVersion returns a new Version with the core version of core for parsing
+// tail, the rest of the version string after the core version, into it. If
+// tail has at most as many pre-release identifiers as fit in a versionBlock,
+// the Version is allocated together with the storage for them.
+func allocVersion[T ~string | ~[]byte](core *Version, tail T) *Version {
+	var b *versionBlock
+
+	if len(tail) == 0 || tail[0] != '-' || countIdentifiers(tail[1:]) > len(b.ids) {
+		v := new(Version)
+		*v = *core
+
+		return v
+	}
+
+	b = new(versionBlock)
+	b.v = *core
+	b.v.Prerelease = b.pre[:0]
+	b.v.ids = b.ids[:0]
+
+	return &b.v
+}
+
+// trimPrerelease sets the Prerelease of a newly parsed v to nil if it is empty
+// and limits its capacity to its length otherwise, so that appending to it
+// cannot overwrite the storage that the parser allocated with v.
+func (v *Version) trimPrerelease() {
+	if n := len(v.Prerelease); n == 0 {
+		v.Prerelease = nil
+	} else {
+		v.Prerelease = v.Prerelease[:n:n]
+	}
+}
+
+// parseInto parses the version string s into v. It reuses the storage of
+// the pre-release and build identifiers of v.
+func (p *Parser) parseInto(v *Version, s string) error {
+	t, offset, pos, err := p.parseHead(v, s)
+	if err != nil {
+		return err
+	}
+
+	return p.parseRest(v, s, t, offset, pos)
+}
+
+// parseHead trims the version string s if p has TrimSpace set and parses
+// the prefix and the core version of it into v. It returns the trimmed string,
+// its offset in s, and the position where the core version ends in it.
+func (p *Parser) parseHead(v *Version, s string) (string, int, int, error) {
+	orig := s
+	offset := 0
+
+	if p.TrimSpace {
+		t := strings.TrimLeft(s, asciiSpace)
+		offset = len(s) - len(t)
+		s = strings.TrimRight(t, asciiSpace)
+	}
+
+	pos, err := parseCore(v, s, p)
+	if err != nil {
+		return "", 0, 0, rebaseParseError(err, orig, offset)
+	}
+
+	return s, offset, pos, nil
+}
+
+// parseRest parses the pre-release and the build identifiers of the trimmed
+// version string s that start at pos into v. The original version string is
+// orig, and s starts at offset in it.
+func (p *Parser) parseRest(v *Version, orig, s string, offset, pos int) error {
+	if p.NormalizeCase {
+		if tail := toLowerASCII(s[pos:]); tail != s[pos:] {
+			s = s[:pos] + tail
+		}
+	}
+
+	if err := parseTail(v, s, pos, p); err != nil {
+		return rebaseParseError(err, orig, offset)
+	}
+
+	v.src = nil
+
+	if p.KeepOriginal {
+		// The prefix was already validated when parsing the core version.
+		n, _ := stripPrefix(s, p.prefixes(), p.NormalizeCase)
+		v.src = &source{
+			text:   orig,
+			prefix: s[:n],
+			core:   strings.Count(s[n:pos], ".") + 1,
+		}
+	}
+
+	return nil
+}
+
+// prefixes returns the allowed prefixes of p.
+func (p *Parser) prefixes() []string {
+	if p.Prefixes == nil {
+		return []string{"v"}
+	}
+
+	return p.Prefixes
+}
+
+// minCore returns the minimum number of core version numbers of p.
+func (p *Parser) minCore() int {
+	if p.MinCore == 0 {
+		return 3 //nolint:mnd // <major>.<minor>.<patch>
+	}
+
+	return p.MinCore
+}
+
+// maxCore returns the maximum number of core version numbers of p.
+func (p *Parser) maxCore() int {
+	if p.MaxCore == 0 {
+		return 3 //nolint:mnd // <major>.<minor>.<patch>
+	}
+
+	return p.MaxCore
+}
+
+// toLowerASCII returns s with the ASCII letters converted to lower case. Unlike
+// [strings.ToLower], it does not change the length of s, and it returns s
+// without allocating if there are no upper-case letters.
+func toLowerASCII(s string) string {
+	i := strings.IndexFunc(s, func(r rune) bool { return 'A' <= r && r <= 'Z' })
+	if i < 0 {
+		return s
+	}
+
+	b := []byte(s)
+
+	for ; i < len(b); i++ {
+		if 'A' <= b[i] && b[i] <= 'Z' {
+			b[i] += 'a' - 'A'
+		}
+	}
+
+	return string(b)
+}
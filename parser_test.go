@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestParser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		p       semver.Parser
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"zero", semver.Parser{}, "v1.2.3-rc.1+b", "1.2.3-rc.1+b", false},
+		{"zero-partial", semver.Parser{}, "1.2", "", true},
+		{"zero-leading-zero", semver.Parser{}, "01.2.3", "", true},
+		{"zero-space", semver.Parser{}, " 1.2.3", "", true},
+		{"zero-upper-prefix", semver.Parser{}, "V1.2.3", "", true},
+		{"lax", semver.Parser{MinCore: 1}, "v1", "1.0.0", false},
+		{"prefixes", semver.Parser{Prefixes: []string{"v", "release-", "="}}, "release-1.2.3", "1.2.3", false},
+		{"prefixes-equals", semver.Parser{Prefixes: []string{"v", "release-", "="}}, "=1.2.3", "1.2.3", false},
+		{"prefixes-not-listed", semver.Parser{Prefixes: []string{"release-"}}, "v1.2.3", "", true},
+		{"prefixes-longest", semver.Parser{Prefixes: []string{"r", "rel"}}, "rel1.2.3", "1.2.3", false},
+		{"no-prefixes", semver.Parser{Prefixes: []string{}}, "v1.2.3", "", true},
+		{"no-prefixes-plain", semver.Parser{Prefixes: []string{}}, "1.2.3", "1.2.3", false},
+		{"trim-space", semver.Parser{TrimSpace: true}, " \t1.2.3\n", "1.2.3", false},
+		{"trim-space-empty", semver.Parser{TrimSpace: true}, "  ", "", true},
+		{"leading-zeros", semver.Parser{AllowLeadingZeros: true}, "01.002.0-rc.01+001", "1.2.0-rc.1+001", false},
+		{"leading-zeros-alnum", semver.Parser{AllowLeadingZeros: true}, "1.2.3-01a", "1.2.3-01a", false},
+		{"max-core", semver.Parser{MaxCore: 4}, "1.2.3.4", "1.2.3+4", false},
+		{"max-core-build", semver.Parser{MaxCore: 5}, "1.2.3.4.5-rc+b", "1.2.3-rc+4.5.b", false},
+		{"max-core-too-many", semver.Parser{MaxCore: 4}, "1.2.3.4.5", "", true},
+		{"max-core-two", semver.Parser{MinCore: 1, MaxCore: 2}, "1.2", "1.2.0", false},
+		{"max-core-two-too-many", semver.Parser{MinCore: 1, MaxCore: 2}, "1.2.3", "", true},
+		{"normalize-case", semver.Parser{NormalizeCase: true}, "V1.2.3-RC.1+Build", "1.2.3-rc.1+build", false},
+		{"big", semver.Parser{AllowBig: true}, "1.2.3-18446744073709551616", "1.2.3-18446744073709551616", false},
+		{"big-leading-zeros", semver.Parser{AllowBig: true, AllowLeadingZeros: true}, "018446744073709551616.0.0", "18446744073709551616.0.0", false},
+		{"invalid-options", semver.Parser{MinCore: 3, MaxCore: 2}, "1.2.3", "", true},
+		{"negative-options", semver.Parser{MinCore: -1}, "1.2.3", "", true},
+		{
+			"all",
+			semver.Parser{
+				Prefixes:          []string{"v", "release-"},
+				MinCore:           1,
+				MaxCore:           4,
+				TrimSpace:         true,
+				AllowLeadingZeros: true,
+				NormalizeCase:     true,
+			},
+			" Release-01.2-Beta.02 ",
+			"1.2.0-beta.2",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.p.Parse(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Parser.Parse(%q) = %v, want error", tt.input, got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parser.Parse(%q) failed unexpectedly: %v", tt.input, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("Parser.Parse(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+
+			if _, err := semver.ParseBig(got.String()); err != nil {
+				t.Errorf("Parser.Parse(%q) = %q, which is not a valid version: %v", tt.input, got, err)
+			}
+		})
+	}
+}
+
+func TestParserErrors(t *testing.T) {
+	t.Parallel()
+
+	p := semver.Parser{TrimSpace: true, NormalizeCase: true}
+
+	_, err := p.Parse("  1.2.3-RC.01")
+
+	var pe *semver.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Parser.Parse() error = %v, want *ParseError", err)
+	}
+
+	if pe.Input != "  1.2.3-RC.01" || pe.Offset != 11 || pe.Kind != semver.KindLeadingZero {
+		t.Errorf(
+			"Parser.Parse() error = %+v, want input %q, offset 11, kind %v",
+			pe,
+			"  1.2.3-RC.01",
+			semver.KindLeadingZero,
+		)
+	}
+
+	_, err = (&semver.Parser{MinCore: 4}).Parse("1.2.3")
+	if !errors.Is(err, semver.ErrParser) {
+		t.Errorf("Parser{MinCore: 4}.Parse() error = %v, want ErrParser", err)
+	}
+}
+
+func TestParserMatchesParse(t *testing.T) {
+	t.Parallel()
+
+	var strict, lax semver.Parser
+
+	lax.MinCore = 1
+
+	for _, s := range []string{"1.2.3", "v1.2", "1", "01.2.3", "1.2.3-rc.1+b", "x1.2.3", "", "1.2.3.4"} {
+		want, wantErr := semver.Parse(s)
+
+		got, err := strict.Parse(s)
+		if (err != nil) != (wantErr != nil) || (err == nil && !got.StrictEqual(want)) {
+			t.Errorf("Parser{}.Parse(%q) = %v, %v, want %v, %v", s, got, err, want, wantErr)
+		}
+
+		want, wantErr = semver.ParseLax(s)
+
+		got, err = lax.Parse(s)
+		if (err != nil) != (wantErr != nil) || (err == nil && !got.StrictEqual(want)) {
+			t.Errorf("Parser{MinCore: 1}.Parse(%q) = %v, %v, want %v, %v", s, got, err, want, wantErr)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pseudoTimeFormat is the layout of the timestamp in Go pseudo-versions.
+const pseudoTimeFormat = "20060102150405"
+
+// ErrInvalidPseudoVersion is returned when a version is not a valid Go
+// pseudo-version.
+var ErrInvalidPseudoVersion = errors.New("invalid pseudo-version")
+
+// A PseudoVersion is a Go pseudo-version, like
+// "v0.0.0-20250101120000-abcdef123456". The Go toolchain uses pseudo-versions
+// to refer to commits that have no semantic version tag. A pseudo-version
+// sorts after its base version, which is the version of the latest tag before
+// the commit, and before the versions that are tagged after the commit.
+//
+// Pseudo-versions have three forms:
+//
+//   - "vX.0.0-yyyymmddhhmmss-abcdef123456" is used when there is no base
+//     version.
+//   - "vX.Y.Z-pre.0.yyyymmddhhmmss-abcdef123456" is used when the base version
+//     is the pre-release "vX.Y.Z-pre".
+//   - "vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdef123456" is used when the base version
+//     is the release "vX.Y.Z".
+type PseudoVersion struct {
+	// Base is the base version of the pseudo-version, or nil if it has no base
+	// version. The build metadata of the base version, like "+incompatible",
+	// is kept in the pseudo-version.
+	Base *Version
+
+	// Major is the major version of the pseudo-version if it has no base
+	// version. It is ignored if Base is set.
+	Major uint64
+
+	// Build is the build metadata of the pseudo-version if it has no base
+	// version, like "incompatible" in
+	// "v2.0.0-20250101120000-abcdef123456+incompatible". It is ignored if
+	// Base is set.
+	Build Build
+
+	// Time is the commit time in UTC.
+	Time time.Time
+
+	// Revision is the commit hash prefix, like "abcdef123456".
+	Revision string
+}
+
+// NewPseudoVersion returns the pseudo-version for the commit with the given
+// time and hash that comes after the base version. If base is nil,
+// the pseudo-version has no base version, its major version is major, and its
+// build metadata is given by the build identifiers, so a major of 2 and
+// the build identifier "incompatible" give
+// "v2.0.0-yyyymmddhhmmss-abcdef123456+incompatible" for the commits of
+// a module without a go.mod file. The major and the build identifiers are
+// ignored if base is set, and the build metadata of base is kept instead. If
+// the revision is a full 40-character hexadecimal commit hash, it is shortened
+// to 12 characters like the Go toolchain does. If base is a release whose
+// patch version is the maximum value of uint64, the pseudo-version has a patch
+// version that does not fit in uint64, like [PseudoVersion.Version] describes.
+//
+// NewPseudoVersion returns an error if the revision is empty or has characters
+// other than ASCII letters and digits, or if the build identifiers are not
+// valid.
+func NewPseudoVersion(
+	base *Version,
+	major uint64,
+	t time.Time,
+	rev string,
+	build ...string,
+) (*PseudoVersion, error) {
+	if !isRevision(rev) {
+		return nil, fmt.Errorf("%w: invalid revision %q", ErrInvalidPseudoVersion, rev)
+	}
+
+	if len(rev) == 40 && isHex(rev) { //nolint:mnd // SHA-1
+		rev = rev[:12]
+	}
+
+	if base != nil {
+		return &PseudoVersion{Base: base, Major: 0, Build: nil, Time: t.UTC(), Revision: rev}, nil
+	}
+
+	b, err := NewBuild(build...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid build metadata: %w", ErrInvalidPseudoVersion, err)
+	}
+
+	if len(b) == 0 {
+		b = nil
+	}
+
+	return &PseudoVersion{Base: nil, Major: major, Build: b, Time: t.UTC(), Revision: rev}, nil
+}
+
+// ParsePseudoVersion parses a Go pseudo-version. The version string may have
+// a 'v' prefix. It returns an error that wraps [ErrInvalidPseudoVersion] if
+// the version is valid but not a pseudo-version.
+func ParsePseudoVersion(s string) (*PseudoVersion, error) {
+	v, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(v.Prerelease)
+	if n == 0 || !v.Prerelease[n-1].IsAlphanumeric() {
+		return nil, fmt.Errorf("%w: %q has no timestamp and revision", ErrInvalidPseudoVersion, s)
+	}
+
+	ts, rev, ok := strings.Cut(v.Prerelease[n-1].String(), "-")
+	if !ok || len(ts) != len(pseudoTimeFormat) || !isNumericIdentifier(ts) || !isRevision(rev) {
+		return nil, fmt.Errorf("%w: %q has no timestamp and revision", ErrInvalidPseudoVersion, s)
+	}
+
+	t, err := time.Parse(pseudoTimeFormat, ts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timestamp in %q: %w", ErrInvalidPseudoVersion, s, err)
+	}
+
+	p := &PseudoVersion{Base: nil, Major: 0, Build: nil, Time: t, Revision: rev}
+
+	switch {
+	case n == 1:
+		if v.Minor != 0 || v.Patch != 0 {
+			return nil, fmt.Errorf("%w: %q has no base version but is not X.0.0", ErrInvalidPseudoVersion, s)
+		}
+
+		p.Major = v.Major
+		p.Build = v.Build
+	case !isZeroIdentifier(v.Prerelease[n-2]):
+		return nil, fmt.Errorf("%w: %q has no \"0\" before the timestamp", ErrInvalidPseudoVersion, s)
+	case n == 2: //nolint:mnd // "0" and the timestamp
+		if v.Patch == 0 {
+			return nil, fmt.Errorf("%w: %q has a release base version with patch -1", ErrInvalidPseudoVersion, s)
+		}
+
+		p.Base = &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1, Build: v.Build}
+	default:
+		p.Base = &Version{
+			Major:      v.Major,
+			Minor:      v.Minor,
+			Patch:      v.Patch,
+			Prerelease: slices.Clone(v.Prerelease[:n-2]),
+			Build:      v.Build,
+		}
+	}
+
+	return p, nil
+}
+
+// String returns the pseudo-version string with the "v" prefix, like
+// "v1.2.4-0.20250101120000-abcdef123456".
+func (p *PseudoVersion) String() string {
+	return "v" + p.Version().String()
+}
+
+// Version returns the pseudo-version as a Version. If the base version is
+// a release whose patch version is the maximum value of uint64, the incremented
+// patch version of the returned Version is a big number, like in the versions
+// parsed using [ParseBig].
+func (p *PseudoVersion) Version() *Version {
+	last := alphanumericIdentifier(p.Time.UTC().Format(pseudoTimeFormat) + "-" + p.Revision)
+
+	if p.Base == nil {
+		return &Version{Major: p.Major, Prerelease: Prerelease{last}, Build: slices.Clone(p.Build)}
+	}
+
+	v := &Version{
+		Major:      p.Base.Major,
+		Minor:      p.Base.Minor,
+		Patch:      p.Base.Patch,
+		big:        p.Base.big,
+		Prerelease: make(Prerelease, 0, len(p.Base.Prerelease)+2), //nolint:mnd // "0" and the timestamp
+		Build:      slices.Clone(p.Base.Build),
+	}
+
+	if len(p.Base.Prerelease) == 0 {
+		d := v.bigDigits()
+		if d[2] == "" && v.Patch == math.MaxUint64 {
+			// The incremented patch version does not fit in uint64, so it is
+			// stored as a big number instead of failing.
+			d[2] = strconv.FormatUint(v.Patch, 10)
+		}
+
+		v.Patch, d[2], _ = incNumber(v.Patch, d[2])
+		v.setBigDigits(d)
+	} else {
+		v.Prerelease = append(v.Prerelease, p.Base.Prerelease...)
+	}
+
+	v.Prerelease = append(v.Prerelease, NumericIdentifier(0), last)
+
+	return v
+}
+
+// isRevision reports whether s is a valid commit hash prefix in
+// a pseudo-version. It must be non-empty and have only ASCII letters and
+// digits.
+func isRevision(s string) bool {
+	for i := range len(s) {
+		if c := s[i]; !isDigit(c) && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// isHex reports whether s has only lower-case hexadecimal digits.
+func isHex(s string) bool {
+	for i := range len(s) {
+		if c := s[i]; !isDigit(c) && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
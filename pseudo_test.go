@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestNewPseudoVersion(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2025, 1, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		base  string
+		major uint64
+		rev   string
+		build []string
+		want  string
+	}{
+		{"", 0, "abcdef123456", nil, "v0.0.0-20250101120000-abcdef123456"},
+		{"", 2, "abcdef123456", nil, "v2.0.0-20250101120000-abcdef123456"},
+		{"", 17, "abcdef123456", nil, "v17.0.0-20250101120000-abcdef123456"},
+		{"", 2, "abcdef123456", []string{"incompatible"}, "v2.0.0-20250101120000-abcdef123456+incompatible"},
+		{"", 0, "0123456789abcdef0123456789abcdef01234567", nil, "v0.0.0-20250101120000-0123456789ab"},
+		{"", 0, "0123456789ABCDEF0123456789ABCDEF01234567", nil, "v0.0.0-20250101120000-0123456789ABCDEF0123456789ABCDEF01234567"},
+		{"1.2.3", 0, "abcdef123456", nil, "v1.2.4-0.20250101120000-abcdef123456"},
+		{"1.2.3", 5, "abcdef123456", nil, "v1.2.4-0.20250101120000-abcdef123456"},
+		{"1.2.3-pre", 0, "abcdef123456", nil, "v1.2.3-pre.0.20250101120000-abcdef123456"},
+		{"1.2.3-rc.1", 0, "abcdef123456", nil, "v1.2.3-rc.1.0.20250101120000-abcdef123456"},
+		{"2.0.0+incompatible", 0, "abcdef123456", nil, "v2.0.1-0.20250101120000-abcdef123456+incompatible"},
+		{"2.0.0+incompatible", 0, "abcdef123456", []string{"x"}, "v2.0.1-0.20250101120000-abcdef123456+incompatible"},
+		{"1.2.18446744073709551615-rc.1", 0, "abc", nil, "v1.2.18446744073709551615-rc.1.0.20250101120000-abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			var base *semver.Version
+			if tt.base != "" {
+				base = semver.MustParse(tt.base)
+			}
+
+			p, err := semver.NewPseudoVersion(base, tt.major, ts, tt.rev, tt.build...)
+			if err != nil {
+				t.Fatalf("NewPseudoVersion(%q, %d, %v, %q, %q) error = %v", tt.base, tt.major, ts, tt.rev, tt.build, err)
+			}
+
+			if got := p.String(); got != tt.want {
+				t.Errorf(
+					"NewPseudoVersion(%q, %d, %v, %q, %q) = %q, want %q",
+					tt.base,
+					tt.major,
+					ts,
+					tt.rev,
+					tt.build,
+					got,
+					tt.want,
+				)
+			}
+
+			q, err := semver.ParsePseudoVersion(tt.want)
+			if err != nil {
+				t.Fatalf("ParsePseudoVersion(%q) error = %v", tt.want, err)
+			}
+
+			if got := q.String(); got != tt.want {
+				t.Errorf("ParsePseudoVersion(%q).String() = %q, want the same", tt.want, got)
+			}
+
+			if q.Major != p.Major {
+				t.Errorf("ParsePseudoVersion(%q).Major = %d, want %d", tt.want, q.Major, p.Major)
+			}
+
+			v := p.Version()
+			if !semver.IsGoModuleValid("v" + v.String()) {
+				t.Errorf("PseudoVersion.Version() = %q, not a valid Go module version", v)
+			}
+
+			if base != nil && v.Compare(base) <= 0 {
+				t.Errorf("PseudoVersion.Version() = %q, not greater than the base %q", v, base)
+			}
+		})
+	}
+}
+
+func TestNewPseudoVersionError(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		base string
+		rev  string
+	}{
+		{"1.2.3", ""},
+		{"1.2.3", "abc-def"},
+		{"1.2.3", "abcdéf"},
+	}
+
+	for _, tt := range tests {
+		p, err := semver.NewPseudoVersion(semver.MustParse(tt.base), 0, ts, tt.rev)
+		if !errors.Is(err, semver.ErrInvalidPseudoVersion) {
+			t.Errorf("NewPseudoVersion(%q, %v, %q) = %v, %v, want error %v", tt.base, ts, tt.rev, p, err, semver.ErrInvalidPseudoVersion)
+		}
+	}
+
+	for _, build := range [][]string{{""}, {"in_compatible"}} {
+		p, err := semver.NewPseudoVersion(nil, 2, ts, "abcdef123456", build...)
+		if !errors.Is(err, semver.ErrInvalidPseudoVersion) {
+			t.Errorf("NewPseudoVersion(nil, 2, %v, %q, %q) = %v, %v, want error %v", ts, "abcdef123456", build, p, err, semver.ErrInvalidPseudoVersion)
+		}
+	}
+}
+
+func TestPseudoVersionMaxPatch(t *testing.T) {
+	t.Parallel()
+
+	base := semver.MustParse("1.2.18446744073709551615")
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	want := "v1.2.18446744073709551616-0.20250101120000-abcdef123456"
+
+	p, err := semver.NewPseudoVersion(base, 0, ts, "abcdef123456")
+	if err != nil {
+		t.Fatalf("NewPseudoVersion(%q, 0, %v, %q) error = %v", base, ts, "abcdef123456", err)
+	}
+
+	q := &semver.PseudoVersion{Base: base, Major: 0, Build: nil, Time: ts, Revision: "abcdef123456"}
+
+	for _, p := range []*semver.PseudoVersion{p, q} {
+		if got := p.String(); got != want {
+			t.Errorf("PseudoVersion.String() = %q, want %q", got, want)
+		}
+
+		if v := p.Version(); v.Compare(base) <= 0 {
+			t.Errorf("PseudoVersion.Version() = %q, not greater than the base %q", v, base)
+		}
+	}
+}
+
+func TestParsePseudoVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in    string
+		base  string
+		major uint64
+		rev   string
+	}{
+		{"v0.0.0-20250101120000-abcdef123456", "", 0, "abcdef123456"},
+		{"v2.0.0-20250101120000-abcdef123456", "", 2, "abcdef123456"},
+		{"0.0.0-20250101120000-abcdef123456", "", 0, "abcdef123456"},
+		{"v1.2.4-0.20250101120000-abcdef123456", "1.2.3", 0, "abcdef123456"},
+		{"v1.2.3-pre.0.20250101120000-abcdef123456", "1.2.3-pre", 0, "abcdef123456"},
+		{"v1.2.3-rc.1.0.20250101120000-abc", "1.2.3-rc.1", 0, "abc"},
+		{"v1.2.3-0.0.20250101120000-abc", "1.2.3-0", 0, "abc"},
+		{"v2.0.1-0.20250101120000-abcdef123456+incompatible", "2.0.0+incompatible", 0, "abcdef123456"},
+		{"v2.0.0-20250101120000-abcdef123456+incompatible", "", 2, "abcdef123456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := semver.ParsePseudoVersion(tt.in)
+			if err != nil {
+				t.Fatalf("ParsePseudoVersion(%q) error = %v", tt.in, err)
+			}
+
+			switch {
+			case tt.base == "" && p.Base != nil:
+				t.Errorf("ParsePseudoVersion(%q).Base = %q, want nil", tt.in, p.Base)
+			case tt.base != "" && (p.Base == nil || !p.Base.StrictEqual(semver.MustParse(tt.base))):
+				t.Errorf("ParsePseudoVersion(%q).Base = %v, want %q", tt.in, p.Base, tt.base)
+			}
+
+			if p.Major != tt.major {
+				t.Errorf("ParsePseudoVersion(%q).Major = %d, want %d", tt.in, p.Major, tt.major)
+			}
+
+			if want := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC); !p.Time.Equal(want) || p.Time.Location() != time.UTC {
+				t.Errorf("ParsePseudoVersion(%q).Time = %v, want %v", tt.in, p.Time, want)
+			}
+
+			if p.Revision != tt.rev {
+				t.Errorf("ParsePseudoVersion(%q).Revision = %q, want %q", tt.in, p.Revision, tt.rev)
+			}
+
+			if got, want := p.Version(), semver.MustParse(tt.in); !got.StrictEqual(want) {
+				t.Errorf("ParsePseudoVersion(%q).Version() = %q, want %q", tt.in, got, want)
+			}
+		})
+	}
+}
+
+func TestParsePseudoVersionError(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"v1.2.3",
+		"v1.2.3-rc.1",
+		"v1.2.3-20250101120000-abcdef123456",
+		"v1.2.0-0.20250101120000-abcdef123456",
+		"v1.2.3-pre.1.20250101120000-abcdef123456",
+		"v0.0.0-2025010112000-abcdef123456",
+		"v0.0.0-20251301120000-abcdef123456",
+		"v0.0.0-20250101120000-",
+		"v0.0.0-20250101120000-abc-def",
+		"v0.0.0-2025010112000a-abcdef123456",
+	}
+
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := semver.ParsePseudoVersion(s)
+			if !errors.Is(err, semver.ErrInvalidPseudoVersion) {
+				t.Errorf("ParsePseudoVersion(%q) = %v, %v, want error %v", s, p, err, semver.ErrInvalidPseudoVersion)
+			}
+		})
+	}
+
+	if _, err := semver.ParsePseudoVersion("v1.2"); !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("ParsePseudoVersion(%q) error = %v, want %v", "v1.2", err, semver.ErrInvalidVersion)
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// A Range is a set of versions represented as a normalized union of disjoint
+// intervals of versions. The intervals are ordered according to
+// [Version.Compare], and they are kept in a canonical form: two Ranges that
+// contain the same versions have the same intervals and the same string
+// representation.
+//
+// Unlike [Constraint], a Range does not have special rules for pre-release
+// versions. A Range contains every version that is within its bounds in
+// the order defined by the semantic versioning specification, including
+// the pre-releases.
+//
+// The zero value of Range is an empty Range.
+type Range struct {
+	intervals []interval
+}
+
+// An interval is a half-open interval of versions from lo, inclusive, to hi,
+// exclusive. Every interval in a Range is stored in this form, as any
+// inclusive upper bound or exclusive lower bound can be written as the other
+// kind by using the successor of the version. If hi is nil, the interval has
+// no upper bound.
+type interval struct {
+	lo *Version
+	hi *Version
+}
+
+// Range returns the set of versions that c matches as a Range. The pre-release
+// rules of c are not applied: the returned Range contains every version within
+// the bounds of the comparators.
+func (c *Constraint) Range() *Range {
+	intervals := make([]interval, 0, len(c.sets))
+
+	for _, set := range c.sets {
+		in := interval{lo: minVersion(), hi: nil}
+
+		for _, cmp := range set {
+			in = in.intersect(cmp.interval())
+		}
+
+		intervals = append(intervals, in)
+	}
+
+	return newRange(intervals)
+}
+
+// Complement returns the Range that contains every version that r does not
+// contain.
+func (r *Range) Complement() *Range {
+	intervals := make([]interval, 0, len(r.intervals)+1)
+	lo := minVersion()
+
+	for _, in := range r.intervals {
+		if lo.Compare(in.lo) < 0 {
+			intervals = append(intervals, interval{lo: lo, hi: in.lo})
+		}
+
+		if in.hi == nil {
+			return &Range{intervals: intervals}
+		}
+
+		lo = in.hi
+	}
+
+	intervals = append(intervals, interval{lo: lo, hi: nil})
+
+	return &Range{intervals: intervals}
+}
+
+// Contains reports whether v is in r.
+func (r *Range) Contains(v *Version) bool {
+	for _, in := range r.intervals {
+		if in.contains(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Equal reports whether r and o contain the same versions.
+func (r *Range) Equal(o *Range) bool {
+	return slices.EqualFunc(r.intervals, o.intervals, func(a, b interval) bool {
+		return a.lo.Equal(b.lo) && compareUpper(a.hi, b.hi) == 0
+	})
+}
+
+// Intersect returns the Range that contains the versions that are in both r
+// and o.
+func (r *Range) Intersect(o *Range) *Range {
+	var intervals []interval
+
+	for _, a := range r.intervals {
+		for _, b := range o.intervals {
+			intervals = append(intervals, a.intersect(b))
+		}
+	}
+
+	return newRange(intervals)
+}
+
+// IsEmpty reports whether r contains no versions.
+func (r *Range) IsEmpty() bool {
+	return len(r.intervals) == 0
+}
+
+// IsSubset reports whether every version in r is also in o.
+func (r *Range) IsSubset(o *Range) bool {
+	return r.Intersect(o.Complement()).IsEmpty()
+}
+
+// Overlaps reports whether r and o have at least one version in common.
+func (r *Range) Overlaps(o *Range) bool {
+	return !r.Intersect(o).IsEmpty()
+}
+
+// String returns the canonical string representation of r in the npm range
+// syntax. The string can be parsed using [ParseConstraint], and the Range of
+// the parsed Constraint is equal to r.
+func (r *Range) String() string {
+	if len(r.intervals) == 0 {
+		return "<0.0.0-0"
+	}
+
+	var sb strings.Builder
+
+	for i, in := range r.intervals {
+		if i > 0 {
+			sb.WriteString(" || ")
+		}
+
+		sb.WriteString(in.String())
+	}
+
+	return sb.String()
+}
+
+// Union returns the Range that contains the versions that are in r, in o, or
+// in both.
+func (r *Range) Union(o *Range) *Range {
+	intervals := make([]interval, 0, len(r.intervals)+len(o.intervals))
+	intervals = append(intervals, r.intervals...)
+	intervals = append(intervals, o.intervals...)
+
+	return newRange(intervals)
+}
+
+// String returns the string representation of the interval as primitive
+// comparators.
+func (in interval) String() string {
+	if in.hi != nil {
+		if s := successor(in.lo); s != nil && s.Equal(in.hi) {
+			return "=" + in.lo.String()
+		}
+	}
+
+	var parts []string
+
+	if !in.lo.Equal(minVersion()) {
+		if p := predecessor(in.lo); p != nil {
+			parts = append(parts, ">"+p.String())
+		} else {
+			parts = append(parts, ">="+in.lo.String())
+		}
+	}
+
+	if in.hi != nil {
+		if p := predecessor(in.hi); p != nil {
+			parts = append(parts, "<="+p.String())
+		} else {
+			parts = append(parts, "<"+in.hi.String())
+		}
+	}
+
+	// The wildcard "*" is not used as npm excludes the pre-releases from it.
+	if len(parts) == 0 {
+		return ">=" + in.lo.String()
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// contains reports whether v is in the interval.
+func (in interval) contains(v *Version) bool {
+	return in.lo.Compare(v) <= 0 && compareUpper(v, in.hi) < 0
+}
+
+// intersect returns the intersection of the two intervals. The returned
+// interval may be empty.
+func (in interval) intersect(o interval) interval {
+	lo := in.lo
+	if o.lo.Compare(lo) > 0 {
+		lo = o.lo
+	}
+
+	hi := in.hi
+	if compareUpper(o.hi, hi) < 0 {
+		hi = o.hi
+	}
+
+	return interval{lo: lo, hi: hi}
+}
+
+// isEmpty reports whether the interval contains no versions.
+func (in interval) isEmpty() bool {
+	return compareUpper(in.lo, in.hi) >= 0
+}
+
+// interval returns the versions that the comparator matches as an interval.
+func (c comparator) interval() interval {
+	v := &Version{Major: c.ver.Major, Minor: c.ver.Minor, Patch: c.ver.Patch}
+	if len(c.ver.Prerelease) > 0 {
+		v.Prerelease = c.ver.Prerelease
+	}
+
+	switch c.op {
+	case opEqual:
+		return interval{lo: v, hi: successor(v)}
+	case opGreater:
+		s := successor(v)
+		if s == nil {
+			return interval{lo: minVersion(), hi: minVersion()}
+		}
+
+		return interval{lo: s, hi: nil}
+	case opGreaterEqual:
+		return interval{lo: v, hi: nil}
+	case opLess:
+		return interval{lo: minVersion(), hi: v}
+	case opLessEqual:
+		return interval{lo: minVersion(), hi: successor(v)}
+	default:
+		// Internal invariant violation.
+		panic(fmt.Sprintf("invalid constraint operator: %d", int(c.op)))
+	}
+}
+
+// newRange creates a Range from the given intervals by removing the empty
+// intervals and merging the overlapping and adjacent ones.
+func newRange(intervals []interval) *Range {
+	intervals = slices.DeleteFunc(intervals, interval.isEmpty)
+
+	slices.SortFunc(intervals, func(a, b interval) int {
+		return a.lo.Compare(b.lo)
+	})
+
+	merged := make([]interval, 0, len(intervals))
+
+	for _, in := range intervals {
+		if len(merged) == 0 {
+			merged = append(merged, in)
+
+			continue
+		}
+
+		last := &merged[len(merged)-1]
+
+		if compareUpper(in.lo, last.hi) > 0 {
+			merged = append(merged, in)
+
+			continue
+		}
+
+		if compareUpper(in.hi, last.hi) > 0 {
+			last.hi = in.hi
+		}
+	}
+
+	return &Range{intervals: merged}
+}
+
+// compareUpper compares two versions like [Compare] but treats nil as
+// a version that is greater than every other version.
+func compareUpper(v, w *Version) int {
+	switch {
+	case v == nil && w == nil:
+		return 0
+	case v == nil:
+		return 1
+	case w == nil:
+		return -1
+	default:
+		return v.Compare(w)
+	}
+}
+
+// minVersion returns the lowest possible version, "0.0.0-0".
+func minVersion() *Version {
+	return withZeroPrerelease(&Version{})
+}
+
+// successor returns the lowest version that is greater than v, or nil if v is
+// the greatest possible version.
+func successor(v *Version) *Version {
+	if len(v.Prerelease) > 0 {
+		pre := make(Prerelease, 0, len(v.Prerelease)+1)
+		pre = append(pre, v.Prerelease...)
+		pre = append(pre, NumericIdentifier(0))
+
+		return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Prerelease: pre}
+	}
+
+	return patchUpper(v)
+}
+
+// predecessor returns the greatest version that is less than v, or nil if
+// there is no such version.
+func predecessor(v *Version) *Version {
+	n := len(v.Prerelease)
+	if n == 0 || !isZeroIdentifier(v.Prerelease[n-1]) {
+		return nil
+	}
+
+	if n > 1 {
+		return &Version{
+			Major:      v.Major,
+			Minor:      v.Minor,
+			Patch:      v.Patch,
+			Prerelease: slices.Clone(v.Prerelease[:n-1]),
+		}
+	}
+
+	if v.Patch == 0 {
+		return nil
+	}
+
+	return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestRangeString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{"*", ">=0.0.0"},
+		{"1.2.3", "=1.2.3"},
+		{"^1.2.3", ">=1.2.3 <2.0.0-0"},
+		{"<=2.3.4", "<=2.3.4"},
+		{">1.2.3", ">1.2.3"},
+		{">1.0.0-alpha", ">1.0.0-alpha"},
+		{"^1.2 || ^1.4", ">=1.2.0 <2.0.0-0"},
+		{"~1.2 || ~1.3", ">=1.2.0 <1.3.0-0 || >=1.3.0 <1.4.0-0"},
+		{"~1.2 || >=1.3.0-0 <1.4", ">=1.2.0 <1.4.0-0"},
+		{"1.2.3 || 1.2.4-0", ">=1.2.3 <=1.2.4-0"},
+		{"<1 || >=3", "<1.0.0-0 || >=3.0.0"},
+		{">=2 <1", "<0.0.0-0"},
+		{"<0.0.0-0 || 1.0.0", "=1.0.0"},
+		{">=0.0.0-0", ">=0.0.0-0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			r := semver.MustParseConstraint(tt.c).Range()
+			if got := r.String(); got != tt.want {
+				t.Errorf("Range(%q).String() = %q, want %q", tt.c, got, tt.want)
+			}
+
+			rt := semver.MustParseConstraint(r.String()).Range()
+			if !rt.Equal(r) {
+				t.Errorf("(round-trip) Range(%q) = %q, want %q", r.String(), rt, r)
+			}
+		})
+	}
+}
+
+func TestRangeAlgebra(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a         string
+		b         string
+		intersect string
+		union     string
+		subset    bool
+	}{
+		{"^1.4", ">=1.9 <3", ">=1.9.0 <2.0.0-0", ">=1.4.0 <3.0.0-0", false},
+		{"~1.4.2", "^1.4", ">=1.4.2 <1.5.0-0", ">=1.4.0 <2.0.0-0", true},
+		{"^1", "^2", "<0.0.0-0", ">=1.0.0 <2.0.0-0 || >=2.0.0 <3.0.0-0", false},
+		{"^1", ">=2.0.0-0 <3", "<0.0.0-0", ">=1.0.0 <3.0.0-0", false},
+		{"<1.0.0", "1.0.0", "<0.0.0-0", "<=1.0.0", false},
+		{"1.0.0-alpha", ">=1.0.0-0 <1.0.0", "=1.0.0-alpha", ">=1.0.0-0 <1.0.0", true},
+		{">=1.0.0-alpha <1.0.0-beta", ">1.0.0-alpha.1", ">1.0.0-alpha.1 <1.0.0-beta", ">=1.0.0-alpha", false},
+		{"<0.0.0-0", "^1", "<0.0.0-0", ">=1.0.0 <2.0.0-0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			a := semver.MustParseConstraint(tt.a).Range()
+			b := semver.MustParseConstraint(tt.b).Range()
+
+			if got := a.Intersect(b).String(); got != tt.intersect {
+				t.Errorf("Range(%q).Intersect(%q) = %q, want %q", tt.a, tt.b, got, tt.intersect)
+			}
+
+			if got := a.Union(b).String(); got != tt.union {
+				t.Errorf("Range(%q).Union(%q) = %q, want %q", tt.a, tt.b, got, tt.union)
+			}
+
+			if got := a.IsSubset(b); got != tt.subset {
+				t.Errorf("Range(%q).IsSubset(%q) = %v, want %v", tt.a, tt.b, got, tt.subset)
+			}
+
+			if got := a.Overlaps(b); got != (tt.intersect != "<0.0.0-0") {
+				t.Errorf("Range(%q).Overlaps(%q) = %v, want %v", tt.a, tt.b, got, !got)
+			}
+		})
+	}
+}
+
+func TestRangeComplement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		want string
+	}{
+		{"*", "<0.0.0"},
+		{">=0.0.0-0", "<0.0.0-0"},
+		{"<0.0.0-0", ">=0.0.0-0"},
+		{">=1.2.0 <1.4.5", "<1.2.0 || >=1.4.5"},
+		{"1.2.3", "<1.2.3 || >1.2.3"},
+		{"<1 || >=3", ">=1.0.0-0 <3.0.0"},
+		{"1.0.0-beta", "<1.0.0-beta || >1.0.0-beta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c, func(t *testing.T) {
+			t.Parallel()
+
+			r := semver.MustParseConstraint(tt.c).Range()
+			c := r.Complement()
+
+			if got := c.String(); got != tt.want {
+				t.Errorf("Range(%q).Complement() = %q, want %q", tt.c, got, tt.want)
+			}
+
+			if !c.Complement().Equal(r) {
+				t.Errorf("Range(%q).Complement().Complement() = %q, want %q", tt.c, c.Complement(), r)
+			}
+
+			if !r.Intersect(c).IsEmpty() {
+				t.Errorf("Range(%q).Intersect(Complement()) is not empty", tt.c)
+			}
+		})
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    string
+		v    string
+		want bool
+	}{
+		{"^1.2.3", "1.5.0", true},
+		{"^1.2.3", "1.5.0-beta", true},
+		{"^1.2.3", "2.0.0-alpha", false},
+		{">1.0.0-alpha <1.0.0", "1.0.0-alpha.0", true},
+		{">1.0.0-alpha <1.0.0", "1.0.0-alpha", false},
+		{"<=1.2.3", "1.2.3+build", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.c+"/"+tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			r := semver.MustParseConstraint(tt.c).Range()
+			if got := r.Contains(semver.MustParse(tt.v)); got != tt.want {
+				t.Errorf("Range(%q).Contains(%q) = %v, want %v", tt.c, tt.v, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// A Scanner reads versions separated by white space, for example one version
+// per line, from an [io.Reader]. Successive calls to [Scanner.Scan] step
+// through the versions. An invalid version does not stop the scan: Scan
+// returns true for it, and [Scanner.ParseErr] reports the problem together
+// with the line number. [Scanner.Err] reports the errors from reading
+// the input.
+//
+//	s := semver.NewScanner(os.Stdin)
+//	for s.Scan() {
+//		if err := s.ParseErr(); err != nil {
+//			log.Print(err)
+//			continue
+//		}
+//		fmt.Println(s.Version())
+//	}
+//	if err := s.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type Scanner struct {
+	scanner *bufio.Scanner
+	parse   func(string) (*Version, error)
+
+	// line is the current line in the input, and tokenLine is the line of
+	// the current token.
+	line      int
+	tokenLine int
+
+	version *Version
+	err     error
+}
+
+// A LineError is the error for an invalid version in the input of a [Scanner].
+type LineError struct {
+	// Line is the line number of the version in the input, starting from 1.
+	Line int
+
+	// Text is the invalid version string.
+	Text string
+
+	// Err is the error from parsing the version. It wraps a [*ParseError].
+	Err error
+}
+
+// NewScanner returns a new Scanner that reads from r and parses the versions
+// using [Parse].
+func NewScanner(r io.Reader) *Scanner {
+	return newScanner(r, Parse)
+}
+
+// NewScannerLax returns a new Scanner that reads from r and parses
+// the versions using [ParseLax].
+func NewScannerLax(r io.Reader) *Scanner {
+	return newScanner(r, ParseLax)
+}
+
+// ScanVersions is a [bufio.SplitFunc] that returns each version string
+// separated by ASCII white space. It never returns an empty string.
+func ScanVersions(data []byte, atEOF bool) (int, []byte, error) {
+	advance, start, end := scanVersion(data, atEOF)
+	if start == end {
+		return advance, nil, nil
+	}
+
+	return advance, data[start:end], nil
+}
+
+// Scan advances the Scanner to the next version, which is then available
+// through [Scanner.Version]. It returns false when the scan stops, either by
+// reaching the end of the input or an error in reading it. Scan returns true
+// for invalid versions, so [Scanner.ParseErr] should be checked after each
+// call.
+func (s *Scanner) Scan() bool {
+	if !s.scanner.Scan() {
+		s.version = nil
+		s.err = nil
+
+		return false
+	}
+
+	s.version, s.err = s.parse(s.scanner.Text())
+	if s.err != nil {
+		s.err = &LineError{Line: s.tokenLine, Text: s.scanner.Text(), Err: s.err}
+	}
+
+	return true
+}
+
+// Version returns the version from the most recent call to [Scanner.Scan]. It
+// returns nil if the version is invalid.
+func (s *Scanner) Version() *Version {
+	return s.version
+}
+
+// Text returns the version string from the most recent call to
+// [Scanner.Scan].
+func (s *Scanner) Text() string {
+	return s.scanner.Text()
+}
+
+// Line returns the line number of the version string from the most recent call
+// to [Scanner.Scan], starting from 1.
+func (s *Scanner) Line() int {
+	return s.tokenLine
+}
+
+// ParseErr returns a [*LineError] if the version string from the most recent
+// call to [Scanner.Scan] is invalid, or nil if it is valid.
+func (s *Scanner) ParseErr() error {
+	return s.err
+}
+
+// Err returns the first error that was encountered in reading the input,
+// excluding [io.EOF]. It does not include the errors for the invalid versions.
+func (s *Scanner) Err() error {
+	if err := s.scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read versions: %w", err)
+	}
+
+	return nil
+}
+
+// Error returns the string representation of the error.
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the error from parsing the version.
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+func newScanner(r io.Reader, parse func(string) (*Version, error)) *Scanner {
+	s := &Scanner{
+		scanner:   bufio.NewScanner(r),
+		parse:     parse,
+		line:      1,
+		tokenLine: 0,
+		version:   nil,
+		err:       nil,
+	}
+
+	s.scanner.Split(s.split)
+
+	return s
+}
+
+// split wraps ScanVersions to keep track of the line numbers.
+func (s *Scanner) split(data []byte, atEOF bool) (int, []byte, error) {
+	advance, start, end := scanVersion(data, atEOF)
+	s.line += bytes.Count(data[:start], []byte{'\n'})
+
+	if start == end {
+		return advance, nil, nil
+	}
+
+	s.tokenLine = s.line
+	s.line += bytes.Count(data[end:advance], []byte{'\n'})
+
+	return advance, data[start:end], nil
+}
+
+// scanVersion finds the next version string in data. It returns the number of
+// bytes to advance the input and the start and the end of the version string in
+// data. The start and the end are equal if there is no version string.
+func scanVersion(data []byte, atEOF bool) (int, int, int) {
+	start := 0
+	for start < len(data) && isSpace(data[start]) {
+		start++
+	}
+
+	for i := start; i < len(data); i++ {
+		if isSpace(data[i]) {
+			return i + 1, start, i
+		}
+	}
+
+	if atEOF && len(data) > start {
+		return len(data), start, len(data)
+	}
+
+	// Request more data.
+	return start, start, start
+}
+
+// isSpace reports whether c is an ASCII white space character.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\v' || c == '\f' || c == '\r'
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/anttikivi/semver"
+)
+
+func TestScanVersions(t *testing.T) {
+	t.Parallel()
+
+	input := "  1.2.3\n\tv2.0.0-rc.1  1.0\r\n\n3.0.0+b"
+
+	s := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+	s.Split(semver.ScanVersions)
+
+	var got []string
+
+	for s.Scan() {
+		got = append(got, s.Text())
+	}
+
+	if err := s.Err(); err != nil {
+		t.Fatalf("Scanner.Err() = %v", err)
+	}
+
+	want := []string{"1.2.3", "v2.0.0-rc.1", "1.0", "3.0.0+b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanVersions tokens = %q, want %q", got, want)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	t.Parallel()
+
+	type entry struct {
+		line    int
+		text    string
+		version string
+		invalid bool
+	}
+
+	tests := []struct {
+		name  string
+		new   func(io.Reader) *semver.Scanner
+		input string
+		want  []entry
+	}{
+		{
+			"strict",
+			semver.NewScanner,
+			"1.2.3\n1.2\n\n  v2.0.0-rc.1 01.2.3\r\n3.0.0",
+			[]entry{
+				{1, "1.2.3", "1.2.3", false},
+				{2, "1.2", "", true},
+				{4, "v2.0.0-rc.1", "2.0.0-rc.1", false},
+				{4, "01.2.3", "", true},
+				{5, "3.0.0", "3.0.0", false},
+			},
+		},
+		{
+			"lax",
+			semver.NewScannerLax,
+			"1.2\nv3\n\n\nx\n",
+			[]entry{
+				{1, "1.2", "1.2.0", false},
+				{2, "v3", "3.0.0", false},
+				{5, "x", "", true},
+			},
+		},
+		{"empty", semver.NewScanner, " \n\t\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := tt.new(iotest.HalfReader(strings.NewReader(tt.input)))
+
+			var got []entry
+
+			for s.Scan() {
+				e := entry{line: s.Line(), text: s.Text(), version: "", invalid: false}
+
+				if err := s.ParseErr(); err != nil {
+					var le *semver.LineError
+					if !errors.As(err, &le) || le.Line != s.Line() || le.Text != s.Text() {
+						t.Errorf("Scanner.ParseErr() = %v, want *LineError for line %d", err, s.Line())
+					}
+
+					var pe *semver.ParseError
+					if !errors.As(err, &pe) || !errors.Is(err, semver.ErrInvalidVersion) {
+						t.Errorf("Scanner.ParseErr() = %v, does not wrap *ParseError", err)
+					}
+
+					if s.Version() != nil {
+						t.Errorf("Scanner.Version() = %v for invalid version", s.Version())
+					}
+
+					e.invalid = true
+				} else {
+					e.version = s.Version().String()
+				}
+
+				got = append(got, e)
+			}
+
+			if err := s.Err(); err != nil {
+				t.Fatalf("Scanner.Err() = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scanner entries = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScannerReadError(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read failed")
+	s := semver.NewScanner(io.MultiReader(strings.NewReader("1.2.3\n"), iotest.ErrReader(errRead)))
+
+	n := 0
+	for s.Scan() {
+		n++
+	}
+
+	if n != 1 {
+		t.Errorf("Scanner.Scan() returned true %d times, want 1", n)
+	}
+
+	if err := s.Err(); !errors.Is(err, errRead) {
+		t.Errorf("Scanner.Err() = %v, want %v", err, errRead)
+	}
+
+	if err := s.ParseErr(); err != nil {
+		t.Errorf("Scanner.ParseErr() = %v after the scan stopped, want nil", err)
+	}
+}
+
+func TestLineError(t *testing.T) {
+	t.Parallel()
+
+	s := semver.NewScanner(strings.NewReader("\n\n1.2"))
+	s.Scan()
+
+	err := s.ParseErr()
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3: ") {
+		t.Errorf("Scanner.ParseErr() = %v, want error starting with %q", err, "line 3: ")
+	}
+}
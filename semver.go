@@ -32,6 +32,10 @@ capabilities of this package include:
     full parsing of the version.
   - Comparing versions.
   - Sorting versions.
+  - Incrementing versions.
+  - Encoding and decoding versions as text, for example in JSON.
+  - Storing versions in databases.
+  - Checking versions against npm-style and Cargo-style version ranges.
 
 The version strings can optionally have a "v" prefix.
 
@@ -53,6 +57,78 @@ The package also offers [MustParse] and [MustParseLax] variants of these
 functions. They are otherwise the same but only return the pointer to [Version].
 They panic on errors.
 
+When the parsing functions fail because of an invalid version string, the
+returned error wraps a [*ParseError] that can be retrieved using [errors.As]. It
+contains the byte offset and length of the invalid part, the kind of the
+problem, and the component of the version that is invalid.
+
+Version strings from other ecosystems can be parsed using a [Parser]. Its
+options allow prefixes other than "v", white space around the version, leading
+zeros, more or fewer than three core version numbers, and upper-case letters.
+The parsed versions adhere to the specification:
+
+	p := semver.Parser{Prefixes: []string{"v", "release-"}, TrimSpace: true}
+	v, err := p.Parse(" release-1.2.3 ")
+
+The parsed versions are normalized, so [Version.String] does not include
+the prefix or the omitted core version numbers. To keep the formatting of
+the input, set KeepOriginal in the [Parser]. Then [Version.Original] returns
+the input byte for byte, and [Version.FormatLike] writes other versions in
+the same style:
+
+	p := semver.Parser{MinCore: 1, KeepOriginal: true}
+	v := p.MustParse("v1.2")
+	next, err := v.IncMinor()
+	s := next.FormatLike(v) // "v1.3"
+
+For hot paths that parse large numbers of versions, [ParseBytes] parses byte
+slices without converting them into strings, and [Version.ParseInto] and
+[Version.ParseBytesInto] parse a string or a byte slice into an existing
+Version, reusing its storage:
+
+	var v semver.Version
+	err := v.ParseInto("1.2.3")
+	err = v.ParseBytesInto([]byte("1.2.4"))
+
+By default, the numbers in the version must fit in uint64. As the semantic
+versioning specification does not limit the size of the numbers, [ParseBig] and
+[ParseLaxBig] accept larger numbers, like timestamps used as pre-release
+identifiers. The large numbers are compared and printed exactly.
+
+Versions can also be created without parsing a string. The pre-release and
+build identifiers are created and validated using [NewPrerelease],
+[NumericIdentifier], [AlphanumericIdentifier], and [NewBuild].
+
+# Finding versions in text
+
+[Find] and [FindAll] return the versions in arbitrary text, like tool output or
+file names, with their byte offsets in the text. [Coerce] returns the first
+version in the text normalized like [ParseLax] normalizes versions:
+
+	v, err := semver.Coerce("release notes for v2.4 (build 17)") // 2.4.0
+
+# Reading version lists
+
+A [Scanner] reads versions separated by white space, like the lines of a file
+or the output of "git tag", from an [io.Reader]. Invalid versions do not stop
+the scan: [Scanner.ParseErr] returns a [*LineError] with the line number of
+the invalid version, and [Scanner.Err] returns the error that stopped reading.
+[NewScanner] parses the versions using [Parse] and [NewScannerLax] using
+[ParseLax]. The split function [ScanVersions] can also be used with
+a [bufio.Scanner] directly:
+
+	s := semver.NewScanner(r)
+	for s.Scan() {
+		if err := s.ParseErr(); err != nil {
+			log.Print(err)
+			continue
+		}
+		fmt.Println(s.Version())
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
 # Validating version strings
 
 The package includes two functions, similar to the parsing functions, for
@@ -64,23 +140,30 @@ Example usage:
 
 	ok := semver.IsValid("1.2.3-beta.1")
 
+To find out why a string is not valid, use [Validate] and [ValidateLax]. They
+check the strings like [IsValid] and [IsValidLax] but return a [*ParseError]
+that describes the problem. They do not allocate memory for valid strings.
+
 # Sorting versions
 
-The package contains the [Versions] type that supports sorting using the Go
-standard library [sort] package. [Versions] is defined as []*Version.
+The package contains the [Versions] type for sorting and searching versions.
+[Versions] is defined as []*Version. It has methods like [Versions.Sort],
+[Versions.Max], [Versions.BinarySearch], and [Versions.Floor] that order
+the versions using [Version.Compare], and it also supports sorting using the Go
+standard library [sort] package.
 
 Example usage:
 
 	a := []string{"1.2.3", "1.0", "1.3", "2", "0.4.2"}
-	slice := make(Versions, len(a))
+	slice := make(semver.Versions, len(a))
 
-	for i, s := range {
+	for i, s := range a {
 		slice[i] = semver.MustParseLax(s)
 	}
 
-	sort.Sort(slice)
+	slice.Sort()
 
-	for _, v := range slice {
+	for v := range slice.All() {
 		fmt.Println(v.String())
 	}
 
@@ -92,6 +175,108 @@ The above code would print:
 	1.3.0
 	2.0.0
 
+# Using versions as map keys
+
+[Version.Key] returns a comparable [Key] that is equal for two versions if and
+only if [Version.Equal] reports them equal, and [Version.StrictKey] returns
+a [StrictKey] that matches [Version.StrictEqual]. The keys can be used in maps
+to deduplicate and index versions:
+
+	seen := make(map[semver.Key]bool)
+	seen[v.Key()] = true
+
+# Incrementing versions
+
+The methods [Version.IncMajor], [Version.IncMinor], [Version.IncPatch],
+[Version.IncPrerelease], and [Version.Finalize] return the next versions using
+the same rules as npm. They never modify the version they are called on, and
+the returned versions do not have build metadata. If a number in the version
+would exceed the maximum value of uint64, they return an error that wraps
+[ErrOverflow].
+
+# Encoding versions
+
+[Version], [Prerelease], and [Build] implement [encoding.TextMarshaler],
+[encoding.TextUnmarshaler], and [encoding.TextAppender], so they can be used
+directly with packages like [encoding/json], [encoding/xml], and [flag].
+A [Version] is decoded using [Parse]. To accept partial version strings, use
+the [LaxVersion] type that is decoded using [ParseLax].
+
+# Storing versions in databases
+
+[Version] implements [database/sql.Scanner] and [database/sql/driver.Valuer], and
+it is stored in databases as the version string. As the version strings do not
+sort correctly, for example "1.10.0" sorts before "1.9.0", the package also
+provides a binary encoding of versions that sorts byte by byte in the same order
+as [Version.Compare]. The encoding is created using [Version.AppendSortable] and
+decoded using [ParseSortable]. The [SortableVersion] type uses the encoding as its
+database value, so it can be stored in a binary column and sorted and indexed by
+the database. A nil *Version or *SortableVersion is stored as NULL, and scanning
+NULL into a *Version variable sets it to nil.
+
+# Checking constraints
+
+The package can parse version ranges that use the syntax of npm into
+[Constraint] values using [ParseConstraint] and [MustParseConstraint]. The
+supported syntax includes the primitive operators like ">=1.2.3", X-ranges like
+"1.2.x", partial versions like "1.2", tilde ranges like "~1.2.3", caret ranges
+like "^1.2.3", hyphen ranges like "1.2 - 1.4", and unions separated by "||".
+Versions can be checked against the constraints using [Version.Satisfies].
+
+Example usage:
+
+	c := semver.MustParseConstraint(">=1.0.0 <2.0.0 || ^3.1")
+	ok := semver.MustParse("1.4.2").Satisfies(c)
+
+Version requirements that use the syntax of Cargo can be parsed using
+[ParseCargoConstraint] and [MustParseCargoConstraint]. In Cargo, a version
+without an operator is a caret requirement, so "1.2" means "^1.2", and
+the comparators are separated by commas, like in ">=1, <3".
+
+To reason about the constraints, they can be converted into [Range] values using
+[Constraint.Range]. A [Range] is a normalized set of version intervals that
+supports the set operations [Range.Intersect], [Range.Union], and
+[Range.Complement], and checks like [Range.IsSubset] and [Range.IsEmpty].
+Unlike constraints, ranges have no special rules for pre-release versions.
+
+# Go module versions
+
+The functions [IsGoModuleValid], [Canonical], [Major], and [MajorMinor] work on
+Go module version strings like the functions of the same names in
+golang.org/x/mod/semver, so they can be used in place of that package. The
+version strings must start with "v", and they may omit the minor and patch
+versions, like "v1.2", if they have no pre-release or build identifiers.
+[CanonicalModule] and [IsIncompatible] handle the "+incompatible" build suffix
+of modules that have a major version of 2 or greater but no go.mod file:
+
+	semver.Canonical("v1.2")                      // "v1.2.0"
+	semver.Major("v2.1.0")                        // "v2"
+	semver.CanonicalModule("v2.0.0+incompatible") // "v2.0.0+incompatible"
+
+A [PseudoVersion] is a Go pseudo-version, like
+"v1.2.4-0.20250101120000-abcdef123456", that refers to a commit without a tag.
+[ParsePseudoVersion] parses the base version, the commit time, and
+the revision from a pseudo-version, and [NewPseudoVersion] creates
+the pseudo-version for a commit like the Go toolchain does:
+
+	p, err := semver.NewPseudoVersion(semver.MustParse("1.2.3"), 0, t, "abcdef123456")
+	s := p.String() // "v1.2.4-0.20250101120000-abcdef123456"
+
+Without a base version, the major version of the module is given instead:
+
+	p, err = semver.NewPseudoVersion(nil, 2, t, "abcdef123456")
+	s = p.String() // "v2.0.0-20250101120000-abcdef123456"
+
+Go toolchain versions, like "go1.21rc2" in the toolchain lines of go.mod files
+or returned by runtime.Version, do not follow the semantic versioning
+specification. They are parsed into [GoVersion] values using [ParseGoVersion]
+and compared using [GoVersion.Compare], which orders them like Go does:
+"go1.21" < "go1.21rc1" < "go1.21.0". [GoVersion.Version] converts them into
+Versions that have the same ordering, so they can be sorted together with
+[Versions], and [Version.GoVersion] converts them back:
+
+	v := semver.MustParseGoVersion("go1.21rc1").Version() // 1.21.0-rc.1
+
 [semantic versioning]: https://semver.org
 [semantic versioning 2.0.0]: https://semver.org/spec/v2.0.0.html
 */
@@ -101,6 +286,7 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
@@ -116,16 +302,47 @@ var (
 	// ErrParser is returned when there is a problem with the parsing that is not
 	// directly related to the caller giving an invalid string.
 	ErrParser = errors.New("parsing failed")
+
+	// ErrOverflow is returned when a version cannot be incremented because
+	// a number in it would exceed the maximum value of uint64.
+	ErrOverflow = errors.New("version number overflows")
 )
 
 // A Version is a parsed instance of a version number that adheres to the
 // semantic versioning 2.0.0.
+//
+// If the version is parsed using [ParseBig] or [ParseLaxBig], the core version
+// numbers may be larger than the maximum value of uint64. In that case,
+// the field of the number is set to [math.MaxUint64], and the exact number is
+// stored within the Version. It is used in the comparisons and in the string
+// representation of the Version.
+//
+// If the version is parsed by a [Parser] with KeepOriginal set, the Version
+// also holds the original text, the prefix, and the number of core version
+// numbers of the version string. They are returned by [Version.Original],
+// [Version.Prefix], and [Version.CoreCount] but do not affect comparing
+// the Version.
 type Version struct {
-	Major      uint64
-	Minor      uint64
-	Patch      uint64
+	Major uint64
+	Minor uint64
+	Patch uint64
+
+	// big holds the core version numbers that do not fit in uint64. It is nil
+	// unless the version was parsed in the big-number mode and had such
+	// numbers. It is placed next to the core version numbers so that
+	// the fields that [Version.Compare] reads share a cache line.
+	big *bigCore
+
 	Prerelease Prerelease
 	Build      Build
+
+	// src holds the original text of the version. It is nil unless
+	// the version was parsed by a Parser with KeepOriginal set.
+	src *source
+
+	// ids holds the pre-release identifiers that the elements of Prerelease
+	// point to when the version is parsed. [Version.ParseInto] reuses it.
+	ids []identifier
 }
 
 // A Prerelease holds the pre-release identifiers of a version.
@@ -136,38 +353,78 @@ type PrereleaseIdentifier interface {
 	// String returns the string representation of the identifier.
 	String() string
 
-	// compare returns
-	//
-	//	-1 if this identifier is less than o,
-	//	 0 if this identifier equals o,
-	//	+1 if this identifier is greater than o.
-	//
-	// The comparison is done according to the semantic versioning specification
-	// for pre-release identifiers.
-	compare(o PrereleaseIdentifier) int
+	// IsAlphanumeric reports whether this PrereleaseIdentifier is alphanumeric.
+	IsAlphanumeric() bool
+
+	// IsNumeric reports whether this PrereleaseIdentifier is numeric.
+	IsNumeric() bool
 
-	// equal tells if the given PrereleaseIdentifier is equal to this one.
-	equal(o PrereleaseIdentifier) bool
+	// Len returns the length of the pre-release identifier in characters.
+	Len() int
 
-	// isAlphanumeric reports whether this PrereleaseIdentifier is alphanumeric.
-	isAlphanumeric() bool
+	// Uint64 returns the value of a numeric identifier. It returns zero for
+	// alphanumeric identifiers, so use IsNumeric to check the kind of
+	// the identifier first. For numbers that do not fit in uint64, it returns
+	// [math.MaxUint64].
+	Uint64() uint64
 
-	// isNumeric reports whether this PrereleaseIdentifier is numeric.
-	isNumeric() bool
+	// ident returns the identifier that implements the interface.
+	ident() *identifier
+}
 
-	// len returns the length of the pre-release identifier in characters.
-	len() int
+// An identifier is the only implementation of [PrereleaseIdentifier]. When
+// a version is parsed, its identifiers are stored in a single array, and
+// the PrereleaseIdentifiers in Prerelease point to the array, so that
+// the identifiers are not allocated one by one. The identifiers are compared
+// and formatted through the concrete type without calling the methods of
+// the interface.
+type identifier struct {
+	// s holds the text of an alphanumeric identifier or the digits of
+	// a numeric identifier that does not fit in uint64. It is empty for other
+	// numeric identifiers.
+	s string
+
+	// n holds the value of a numeric identifier, or math.MaxUint64 for
+	// the numbers that do not fit in uint64. For alphanumeric identifiers, it
+	// holds the first eight bytes of s in big-endian order, padded with zeros,
+	// so that most alphanumeric identifiers can be compared without reading s.
+	// The prefix cannot be math.MaxUint64 as the identifiers are ASCII.
+	n uint64
 }
 
 // Build is a list of build identifiers in the Version.
 type Build []string
 
-type alphanumericIdentifier struct {
-	v string
+// A source holds the original text of a parsed version and the parts of
+// the formatting that are lost when parsing.
+type source struct {
+	text   string
+	prefix string
+	core   int
+}
+
+// A bigCore holds the digits of the core version numbers that do not fit in
+// uint64. The numbers that fit are empty strings.
+type bigCore struct {
+	major string
+	minor string
+	patch string
 }
 
-type numericIdentifier struct {
-	v uint64
+// AlphanumericIdentifier returns a new alphanumeric PrereleaseIdentifier for
+// the given string. The string must contain only ASCII alphanumerics and
+// hyphens, and at least one of the characters must not be a digit.
+func AlphanumericIdentifier(s string) (PrereleaseIdentifier, error) {
+	p, err := parsePrereleaseIdentifier(s, false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create alphanumeric identifier: %w", err)
+	}
+
+	if !p.IsAlphanumeric() {
+		return nil, fmt.Errorf("%w: identifier %q is numeric", ErrInvalidVersion, s)
+	}
+
+	return p, nil
 }
 
 // MustParse parses the given string into a Version and panics if it encounters
@@ -181,6 +438,17 @@ func MustParse(s string) *Version {
 	return v
 }
 
+// MustParseBig parses the given string into a Version like [ParseBig] and
+// panics if it encounters an error.
+func MustParseBig(s string) *Version {
+	v, err := ParseBig(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %q into a version: %v", s, err))
+	}
+
+	return v
+}
+
 // MustParseLax parses the given string into a Version and panics if it
 // encounters an error. The version string number may be partial, i.e. it parses
 // 'v1' into '1.0.0' and 'v1.2' into '1.2.0'. The version may have a 'v' prefix.
@@ -193,22 +461,170 @@ func MustParseLax(s string) *Version {
 	return v
 }
 
+// NewBuild returns new [Build] for the given build identifiers. The identifiers
+// must be non-empty and contain only ASCII alphanumerics and hyphens.
+func NewBuild(s ...string) (Build, error) {
+	b := make(Build, 0, len(s))
+
+	for _, ident := range s {
+		if ident == "" {
+			return nil, newParseError(ident, 0, 0, KindEmptyIdentifier, ComponentBuild)
+		}
+
+		for i := range len(ident) {
+			if !isIdentifierCharacter(ident[i]) {
+				return nil, newParseError(ident, i, 1, KindInvalidCharacter, ComponentBuild)
+			}
+		}
+
+		b = append(b, ident)
+	}
+
+	return b, nil
+}
+
+// NewPrerelease returns new [Prerelease] from the given elements. The elements
+// must be strings, ints, uint64s, or PrereleaseIdentifiers. The strings are
+// parsed into numeric or alphanumeric identifiers, and the integers are
+// numeric identifiers.
+func NewPrerelease(a ...any) (Prerelease, error) {
+	identifiers := make(Prerelease, 0, len(a))
+
+	for _, v := range a {
+		switch u := v.(type) {
+		case int:
+			if u < 0 {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidVersion, v)
+			}
+
+			identifiers = append(identifiers, NumericIdentifier(uint64(u)))
+		case uint64:
+			identifiers = append(identifiers, NumericIdentifier(u))
+		case string:
+			p, err := parsePrereleaseIdentifier(u, false)
+			if err != nil {
+				return nil, fmt.Errorf("cannot create Prerelease: %w", err)
+			}
+
+			identifiers = append(identifiers, p)
+		case PrereleaseIdentifier:
+			identifiers = append(identifiers, u)
+		default:
+			return nil, fmt.Errorf("%w: %v", ErrInvalidVersion, v)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// NumericIdentifier returns a new numeric PrereleaseIdentifier for the given
+// number.
+func NumericIdentifier(u uint64) PrereleaseIdentifier {
+	return &identifier{s: "", n: u}
+}
+
 // Parse parses the given string into a Version. The version string may have
 // a 'v' prefix.
 func Parse(s string) (*Version, error) {
-	v, err := parse(s, 3) //nolint:mnd // <major>.<minor>.<patch>
+	v, err := (&Parser{}).parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return v, nil
+}
+
+// ParseBig parses the given string into a Version like [Parse] but allows
+// the numeric identifiers in the version to be larger than the maximum value
+// of uint64, as the semantic versioning specification does not limit their
+// size. The large numbers are compared by their length first and then by their
+// digits, and they are kept as is in the string representation of the version.
+func ParseBig(s string) (*Version, error) {
+	v, err := (&Parser{AllowBig: true}).parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return v, nil
+}
+
+// ParseBytes parses the given byte slice into a Version like [Parse]. It does
+// not convert the whole slice into a string, and the returned Version does not
+// keep references to b. If the version has pre-release or build identifiers,
+// they share a single string that is copied from b.
+func ParseBytes(b []byte) (*Version, error) {
+	var (
+		p    Parser
+		core Version
+	)
+
+	pos, err := parseCore(&core, b, &p)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse version: %w", err)
 	}
 
+	v := allocVersion(&core, b[pos:])
+
+	if pos < len(b) {
+		if err := parseTail(v, string(b), pos, &p); err != nil {
+			return nil, fmt.Errorf("failed to parse version: %w", err)
+		}
+	}
+
+	v.trimPrerelease()
+
 	return v, nil
 }
 
+// ParseBytesInto parses the given byte slice into v like [Version.ParseInto].
+// Like [ParseBytes], it does not keep references to b, so parsing a version
+// that has only the core version allocates no memory, and parsing a version
+// with pre-release or build identifiers allocates the string that they share.
+// If b is not a valid version, ParseBytesInto returns an error and
+// the contents of v are unspecified.
+func (v *Version) ParseBytesInto(b []byte) error {
+	if err := v.parseBytes(b); err != nil {
+		return fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return nil
+}
+
+// ParseInto parses the given string into v like [Parse]. It reuses the storage
+// of v, including the backing arrays of v.Prerelease and v.Build and
+// the pre-release identifiers that v.Prerelease points to, so parsing many
+// versions into the same Version allocates no memory for versions that have
+// only the core version and amortizes the allocations for the identifiers.
+// The identifiers in v refer to s, and neither the slices of v nor
+// the PrereleaseIdentifiers in them may be retained by the caller across calls
+// to ParseInto. If s is not a valid
+// version string, ParseInto returns an error and the contents of v are
+// unspecified.
+func (v *Version) ParseInto(s string) error {
+	if err := (&Parser{}).parseInto(v, s); err != nil {
+		return fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return nil
+}
+
 // ParseLax parses the given string into a Version. The version number may be
 // partial, i.e. it parses 'v1' into '1.0.0' and 'v1.2' into '1.2.0'.
 // The version string may have a 'v' prefix.
 func ParseLax(s string) (*Version, error) {
-	v, err := parse(s, 0)
+	v, err := (&Parser{MinCore: 1}).parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return v, nil
+}
+
+// ParseLaxBig parses the given string into a Version like [ParseLax] but allows
+// the numeric identifiers in the version to be larger than the maximum value
+// of uint64 like [ParseBig].
+func ParseLaxBig(s string) (*Version, error) {
+	v, err := (&Parser{MinCore: 1, AllowBig: true}).parse(s)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse version: %w", err)
 	}
@@ -224,54 +640,75 @@ func ParseLax(s string) (*Version, error) {
 //
 // The comparison is done according to the semantic versioning specification.
 func (v *Version) Compare(w *Version) int {
-	var d int
+	if v.big != nil || w.big != nil {
+		if d := v.compareBigCore(w); d != 0 {
+			return d
+		}
+	} else if v.Major != w.Major || v.Minor != w.Minor || v.Patch != w.Patch {
+		return compareCore(v, w)
+	}
 
-	if d = cmp.Compare(v.Major, w.Major); d != 0 {
-		return d
+	switch {
+	case len(v.Prerelease) == 0:
+		if len(w.Prerelease) == 0 {
+			return 0
+		}
+
+		return 1
+	case len(w.Prerelease) == 0:
+		return -1
+	default:
+		return v.Prerelease.compare(w.Prerelease)
 	}
+}
 
-	if d = cmp.Compare(v.Minor, w.Minor); d != 0 {
-		return d
+// compareCore compares the core versions of v and w that fit in uint64.
+func compareCore(v, w *Version) int {
+	if v.Major != w.Major {
+		return compareUint(v.Major, w.Major)
 	}
 
-	if d = cmp.Compare(v.Patch, w.Patch); d != 0 {
-		return d
+	if v.Minor != w.Minor {
+		return compareUint(v.Minor, w.Minor)
 	}
 
-	if v.Prerelease == nil && w.Prerelease != nil {
-		return 1
+	return compareUint(v.Patch, w.Patch)
+}
+
+// compareUint compares a and b like [cmp.Compare] in a form that the compiler
+// can turn into conditional moves, as the order of the numbers in a sort is
+// hard to predict.
+func compareUint(a, b uint64) int {
+	var gt, lt int
+
+	if a > b {
+		gt = 1
 	}
 
-	if v.Prerelease != nil && w.Prerelease == nil {
-		return -1
+	if a < b {
+		lt = 1
 	}
 
-	return v.Prerelease.compare(w.Prerelease)
+	return gt - lt
 }
 
 // ComparableString returns the comparable string representation of the version.
 // It doesn't include the build metadata.
 func (v *Version) ComparableString() string {
-	var sb strings.Builder
-
-	sb.WriteString(strconv.FormatUint(v.Major, 10))
-	sb.WriteByte('.')
-	sb.WriteString(strconv.FormatUint(v.Minor, 10))
-	sb.WriteByte('.')
-	sb.WriteString(strconv.FormatUint(v.Patch, 10))
+	b := v.appendCore(nil)
 
 	if len(v.Prerelease) > 0 {
-		sb.WriteByte('-')
-		sb.WriteString(v.Prerelease.String())
+		b = append(b, '-')
+		b, _ = v.Prerelease.AppendText(b)
 	}
 
-	return sb.String()
+	return string(b)
 }
 
 // CoreString returns the core version string representation of the version. It
 // doesn't include the pre-release nor the build metadata.
 func (v *Version) CoreString() string {
-	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	return string(v.appendCore(nil))
 }
 
 // Equal reports whether Version w is equal to v. The two Versions are equal
@@ -284,6 +721,7 @@ func (v *Version) Equal(w *Version) bool {
 	}
 
 	return v.Major == w.Major && v.Minor == w.Minor && v.Patch == w.Patch &&
+		v.bigDigits() == w.bigDigits() &&
 		v.Prerelease.equal(w.Prerelease)
 }
 
@@ -295,31 +733,16 @@ func (v *Version) StrictEqual(w *Version) bool {
 	}
 
 	return v.Major == w.Major && v.Minor == w.Minor && v.Patch == w.Patch &&
+		v.bigDigits() == w.bigDigits() &&
 		v.Prerelease.equal(w.Prerelease) &&
 		v.Build.equal(w.Build)
 }
 
 // String returns the string representation of v.
 func (v *Version) String() string {
-	var sb strings.Builder
-
-	sb.WriteString(strconv.FormatUint(v.Major, 10))
-	sb.WriteByte('.')
-	sb.WriteString(strconv.FormatUint(v.Minor, 10))
-	sb.WriteByte('.')
-	sb.WriteString(strconv.FormatUint(v.Patch, 10))
-
-	if len(v.Prerelease) > 0 {
-		sb.WriteByte('-')
-		sb.WriteString(v.Prerelease.String())
-	}
-
-	if len(v.Build) > 0 {
-		sb.WriteByte('+')
-		sb.WriteString(v.Build.String())
-	}
+	b, _ := v.AppendText(nil)
 
-	return sb.String()
+	return string(b)
 }
 
 // String returns the string representation of p.
@@ -328,25 +751,16 @@ func (p Prerelease) String() string {
 		return ""
 	}
 
-	var sb strings.Builder
-
-	for i, ident := range p {
-		if i > 0 {
-			sb.WriteRune('.')
-		}
-
-		switch v := ident.(type) {
-		case alphanumericIdentifier:
-			sb.WriteString(v.v)
-		case numericIdentifier:
-			sb.WriteString(strconv.FormatUint(v.v, 10))
-		default:
-			// Internal invariant violation.
-			panic(fmt.Sprintf("invalid pre-release identifier option: %[1]v (%[1]T)", v))
+	// A single alphanumeric identifier can be returned without allocating.
+	if len(p) == 1 {
+		if i := identifierOf(p[0]); i.IsAlphanumeric() {
+			return i.s
 		}
 	}
 
-	return sb.String()
+	b, _ := p.AppendText(nil)
+
+	return string(b)
 }
 
 // String returns the string representation of b.
@@ -355,6 +769,10 @@ func (b Build) String() string {
 		return ""
 	}
 
+	if len(b) == 1 {
+		return b[0]
+	}
+
 	var sb strings.Builder
 
 	for i, s := range b {
@@ -369,13 +787,48 @@ func (b Build) String() string {
 }
 
 // String returns the string representation of the identifier.
-func (i alphanumericIdentifier) String() string {
-	return i.v
+func (i *identifier) String() string {
+	if i.s != "" {
+		return i.s
+	}
+
+	return strconv.FormatUint(i.n, 10)
 }
 
-// String returns the string representation of the identifier.
-func (i numericIdentifier) String() string {
-	return strconv.FormatUint(i.v, 10)
+// IsAlphanumeric reports whether this PrereleaseIdentifier is alphanumeric.
+func (i *identifier) IsAlphanumeric() bool {
+	return i.s != "" && i.n != math.MaxUint64
+}
+
+// IsNumeric reports whether this PrereleaseIdentifier is numeric.
+func (i *identifier) IsNumeric() bool {
+	return !i.IsAlphanumeric()
+}
+
+// Len returns the length of the pre-release identifier in characters.
+func (i *identifier) Len() int {
+	if i.s != "" {
+		return len(i.s)
+	}
+
+	return countDigits(i.n)
+}
+
+// Uint64 returns the value of a numeric identifier. It returns zero for
+// alphanumeric identifiers, so use IsNumeric to check the kind of
+// the identifier first. For numbers that do not fit in uint64, it returns
+// [math.MaxUint64].
+func (i *identifier) Uint64() uint64 {
+	if i.IsAlphanumeric() {
+		return 0
+	}
+
+	return i.n
+}
+
+// ident returns i.
+func (i *identifier) ident() *identifier {
+	return i
 }
 
 // Compare returns
@@ -389,229 +842,339 @@ func Compare(v, w *Version) int {
 	return v.Compare(w)
 }
 
-//nolint:cyclop,funlen,gocognit // TODO: see if worth fixing
-func parse(s string, minCore int) (*Version, error) {
-	if s == "" {
-		return nil, fmt.Errorf("%w: empty string", ErrInvalidVersion)
-	}
-
-	if !isASCII(s) {
-		return nil, fmt.Errorf("%w: version contains non-ASCII characters", ErrInvalidVersion)
-	}
+// parseBytes parses the version in b into v. It reuses the storage of v like
+// [Parser.parseInto].
+func (v *Version) parseBytes(b []byte) error {
+	var p Parser
 
-	pos, err := stripPrefix(s)
+	pos, err := parseCore(v, b, &p)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse the version prefix: %w", err)
+		return err
 	}
 
-	i := len(s)
+	v.src = nil
 
-	for j := range s[pos:] {
-		c := s[pos+j]
-		if !isDigit(c) && c != '.' {
-			i = pos + j
-
-			break
-		}
+	if pos == len(b) {
+		return nil
 	}
 
-	nums := strings.Split(s[pos:i], ".")
+	return parseTail(v, string(b), pos, &p)
+}
 
-	if len(nums) > 3 { //nolint:mnd // <major>.<minor>.<patch>
-		return nil, fmt.Errorf("%w: too many core version numbers in %q", ErrInvalidVersion, s)
+// parseCore parses the prefix and the core version from the start of s into v
+// using the options of p. It returns the position where the core version ends.
+// The pre-release identifiers of v are truncated, and the build identifiers of
+// v are truncated and set to the core version numbers after the patch version.
+// It is generic over the type of s so that byte slices can be parsed without
+// converting them into strings.
+//
+//nolint:cyclop,funlen,gocognit // TODO: see if worth fixing
+func parseCore[T ~string | ~[]byte](v *Version, s T, p *Parser) (int, error) {
+	if len(s) == 0 {
+		return 0, newParseError("", 0, 0, KindEmpty, ComponentCore)
 	}
 
-	if len(nums) < minCore {
-		return nil, fmt.Errorf("%w: not enough core version numbers in %q", ErrInvalidVersion, s)
+	pos, err := stripPrefix(s, p.prefixes(), p.NormalizeCase)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse the version prefix: %w", err)
 	}
 
-	major := uint64(0)
-	minor := uint64(0)
-	patch := uint64(0)
-
-	for j, n := range nums {
-		if n == "" {
-			return nil, fmt.Errorf("%w: empty version number in %q", ErrInvalidVersion, s)
+	var (
+		nums  [3]uint64
+		big   [3]string
+		n     int
+		extra = v.Build[:0]
+	)
+
+	for start := pos; ; {
+		i := start
+		for i < len(s) && isDigit(s[i]) {
+			i++
 		}
 
-		if !isNumericIdentifier(n) {
-			return nil, fmt.Errorf("%w: version number %q is not a number", ErrInvalidVersion, n)
+		if n == p.maxCore() {
+			return 0, newParseError(
+				string(s),
+				start-1,
+				i-start+1,
+				KindTooManyCoreNumbers,
+				ComponentCore,
+			)
 		}
 
-		// If the number is only a zero, we already have the correct value and
-		// can move on to the next one.
-		if n == "0" {
-			continue
+		if i == start {
+			return 0, newParseError(string(s), start, 0, KindEmptyIdentifier, ComponentCore)
 		}
 
-		if n[0] == '0' {
-			return nil, fmt.Errorf("%w: leading zero in %q", ErrInvalidVersion, n)
-		}
+		num := s[start:i]
 
-		u, err := strconv.ParseUint(n, 10, 64)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert the string %q to uint64: %w", n, err)
+		if len(num) > 1 && num[0] == '0' && !p.AllowLeadingZeros {
+			return 0, newParseError(string(s), start, len(num), KindLeadingZero, ComponentCore)
 		}
 
-		switch j {
-		case 0:
-			major = u
-		case 1:
-			minor = u
-		case 2: //nolint:mnd // represents the index
-			patch = u
+		u, ok := parseUint(num)
+
+		switch {
+		case n >= len(nums):
+			// The numbers after the patch version are kept as build
+			// identifiers.
+			extra = append(extra, string(num))
+		case ok:
+			nums[n] = u
+		case p.AllowBig:
+			nums[n] = math.MaxUint64
+			big[n] = string(trimLeadingZeros(num))
 		default:
-			return nil, fmt.Errorf(
-				"%w: index for checking version number is out of bounds: %d",
-				ErrParser,
-				j,
-			)
+			_, err := strconv.ParseUint(string(num), 10, 64)
+			pe := newParseError(string(s), start, len(num), KindOverflow, ComponentCore)
+			pe.Err = err
+
+			return 0, pe
+		}
+
+		n++
+
+		if i < len(s) && s[i] == '.' {
+			start = i + 1
+
+			continue
 		}
+
+		pos = i
+
+		break
 	}
 
-	pos = i
+	if n < p.minCore() {
+		return 0, newParseError(string(s), pos, 0, KindTooFewCoreNumbers, ComponentCore)
+	}
 
 	if pos < len(s) && s[pos] != '-' && s[pos] != '+' {
-		return nil, fmt.Errorf("%w: invalid char %q at %d", ErrInvalidVersion, s[pos], pos)
+		return 0, newParseError(string(s), pos, 1, KindInvalidCharacter, ComponentCore)
 	}
 
-	var prerelease Prerelease
+	v.Major = nums[0]
+	v.Minor = nums[1]
+	v.Patch = nums[2]
+	v.Prerelease = v.Prerelease[:0]
+	v.Build = extra
+	v.setBigDigits(big)
+
+	return pos, nil
+}
+
+// parseTail parses the pre-release and build identifiers that start at pos in
+// s into v using the options of p. The identifiers are appended to the slices
+// of v.
+func parseTail(v *Version, s string, pos int, p *Parser) error {
+	prerelease := v.Prerelease
 
 	if pos < len(s) && s[pos] == '-' {
 		// The hyphen is not passed to the parser.
 		pos++
 
-		i = len(s)
+		// The identifiers are stored in v.ids, which must not be reallocated
+		// while the elements of prerelease point to it.
+		n := countIdentifiers(s[pos:])
+		ids := v.ids[:0]
 
-		for j := range s[pos:] {
-			c := s[pos+j]
-			if c == '+' {
-				i = pos + j
+		if cap(ids) < n {
+			ids = make([]identifier, 0, n)
+		}
 
-				break
-			}
+		if cap(prerelease) < n {
+			prerelease = make(Prerelease, 0, n)
 		}
 
-		parts := strings.Split(s[pos:i], ".")
-		prerelease = make(Prerelease, 0, len(parts))
+		for {
+			i := pos
+			for i < len(s) && s[i] != '.' && s[i] != '+' {
+				i++
+			}
+
+			part := s[pos:i]
+			if p.AllowLeadingZeros && isNumericIdentifier(part) {
+				part = trimLeadingZeros(part)
+			}
 
-		for _, v := range parts {
-			p, err := parsePrereleaseIdentifier(v)
+			ident, err := parseIdentifier(part, p.AllowBig)
 			if err != nil {
-				return nil, fmt.Errorf("parsing prerelease %q failed: %w", s, err)
+				return fmt.Errorf(
+					"parsing prerelease %q failed: %w",
+					s,
+					rebaseParseError(err, s, pos),
+				)
+			}
+
+			ids = append(ids, ident)
+			prerelease = append(prerelease, &ids[len(ids)-1])
+			pos = i
+
+			if i == len(s) || s[i] != '.' {
+				break
 			}
 
-			prerelease = append(prerelease, p)
+			pos++
 		}
 
-		pos = i
+		v.ids = ids
 	}
 
-	var build Build
+	v.Prerelease = prerelease
+	build := v.Build
 
 	if pos < len(s) && s[pos] == '+' {
 		// Move past the '+'.
 		pos++
 
-		build, err = parseBuild(s[pos:])
+		var err error
+
+		build, err = appendBuild(build, s[pos:])
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse the build identifiers: %w", err)
+			return fmt.Errorf(
+				"failed to parse the build identifiers: %w",
+				rebaseParseError(err, s, pos),
+			)
 		}
 	}
 
-	return &Version{
-		Major:      major,
-		Minor:      minor,
-		Patch:      patch,
-		Prerelease: prerelease,
-		Build:      build,
-	}, nil
-}
+	v.Build = build
 
-// newPrerelease creates new [Prerelease] from the given elements. The elements
-// must be strings, ints, or uint64s.
-func newPrerelease(a ...any) (Prerelease, error) {
-	identifiers := make(Prerelease, 0, len(a))
+	return nil
+}
 
-	for _, v := range a {
-		switch u := v.(type) {
-		case int:
-			if u < 0 {
-				return nil, fmt.Errorf("%w: %v", ErrInvalidVersion, v)
-			}
+// countIdentifiers returns the number of pre-release identifiers in s that
+// starts with the pre-release of a version. It counts the dots before
+// the build metadata, so it does not validate the identifiers.
+func countIdentifiers[T ~string | ~[]byte](s T) int {
+	n := 1
 
-			identifiers = append(identifiers, numericIdentifier{uint64(u)})
-		case uint64:
-			identifiers = append(identifiers, numericIdentifier{u})
-		case string:
-			if !isASCII(u) {
-				return nil, fmt.Errorf(
-					"%w: identifier %q contains non-ASCII characters",
-					ErrInvalidVersion,
-					u,
-				)
-			}
+	for i := 0; i < len(s) && s[i] != '+'; i++ {
+		if s[i] == '.' {
+			n++
+		}
+	}
 
-			p, err := parsePrereleaseIdentifier(u)
-			if err != nil {
-				return nil, fmt.Errorf("cannot create Prerelease: %w", err)
-			}
+	return n
+}
 
-			identifiers = append(identifiers, p)
-		default:
-			return nil, fmt.Errorf("%w: %v", ErrInvalidVersion, v)
-		}
+// parsePrereleaseIdentifier parses s into a new PrereleaseIdentifier.
+func parsePrereleaseIdentifier(s string, allowBig bool) (PrereleaseIdentifier, error) {
+	i, err := parseIdentifier(s, allowBig)
+	if err != nil {
+		return nil, err
 	}
 
-	return identifiers, nil
+	return &i, nil
 }
 
-//nolint:ireturn // interface return is needed
-func parsePrereleaseIdentifier(s string) (PrereleaseIdentifier, error) {
+// parseIdentifier parses s into a pre-release identifier.
+func parseIdentifier(s string, allowBig bool) (identifier, error) {
 	if s == "" {
-		return nil, fmt.Errorf("%w: identifier is an empty string", ErrInvalidVersion)
+		return identifier{}, newParseError(s, 0, 0, KindEmptyIdentifier, ComponentPrerelease)
 	}
 
 	// Check the case for single zero early.
 	if s == "0" {
-		return numericIdentifier{0}, nil
+		return identifier{s: "", n: 0}, nil
 	}
 
-	switch {
-	case isNumericIdentifier(s):
-		// If this is a numeric identifier and the first character is zero, we
-		// already know that the length is greater than 1 as the case for that
-		// was checked at the start.
-		if s[0] == '0' {
-			return nil, fmt.Errorf(
-				"%w: numeric identifier with a leading zero: %s",
-				ErrInvalidVersion,
+	for i := range len(s) {
+		if !isIdentifierCharacter(s[i]) {
+			return identifier{}, newParseError(
 				s,
+				i,
+				1,
+				KindInvalidCharacter,
+				ComponentPrerelease,
 			)
 		}
+	}
 
-		u, err := strconv.ParseUint(s, 10, 64)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"failed to convert pre-release identifier to integer: %w",
-				err,
-			)
-		}
+	if !isNumericIdentifier(s) {
+		return alphanumeric(s), nil
+	}
 
-		return numericIdentifier{u}, nil
-	case isAlphanumericIdentifier(s):
-		return alphanumericIdentifier{s}, nil
+	// If this is a numeric identifier and the first character is zero, we
+	// already know that the length is greater than 1 as the case for that was
+	// checked at the start.
+	if s[0] == '0' {
+		return identifier{}, newParseError(
+			s,
+			0,
+			len(s),
+			KindLeadingZero,
+			ComponentPrerelease,
+		)
+	}
+
+	u, ok := parseUint(s)
+
+	switch {
+	case ok:
+		return identifier{s: "", n: u}, nil
+	case allowBig:
+		return bigNumeric(s), nil
 	default:
-		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, s)
+		_, err := strconv.ParseUint(s, 10, 64)
+		pe := newParseError(s, 0, len(s), KindOverflow, ComponentPrerelease)
+		pe.Err = err
+
+		return identifier{}, pe
 	}
 }
 
-// newBuild returns new [Build] for the given strings.
-func newBuild(s ...string) Build {
-	b := make(Build, 0, len(s))
-	b = append(b, s...)
+// alphanumericIdentifier returns a new alphanumeric PrereleaseIdentifier for s
+// without validating it.
+func alphanumericIdentifier(s string) PrereleaseIdentifier {
+	i := alphanumeric(s)
 
-	return b
+	return &i
+}
+
+// alphanumeric returns an alphanumeric identifier for s without validating it.
+func alphanumeric(s string) identifier {
+	var n uint64
+
+	for i := range 8 { //nolint:mnd // bytes in uint64
+		n <<= 8 //nolint:mnd // bits in a byte
+
+		if i < len(s) {
+			n |= uint64(s[i])
+		}
+	}
+
+	return identifier{s: s, n: n}
+}
+
+// bigNumericIdentifier returns a new numeric PrereleaseIdentifier for
+// the digits of a number that does not fit in uint64 without validating them.
+func bigNumericIdentifier(digits string) PrereleaseIdentifier {
+	i := bigNumeric(digits)
+
+	return &i
+}
+
+// bigNumeric returns a numeric identifier for the digits of a number that does
+// not fit in uint64 without validating them.
+func bigNumeric(digits string) identifier {
+	return identifier{s: digits, n: math.MaxUint64}
+}
+
+// identifierOf returns the identifier that p points to.
+func identifierOf(p PrereleaseIdentifier) *identifier {
+	return p.(*identifier) //nolint:forcetypeassert // identifier is the only implementation
+}
+
+// isZeroIdentifier reports whether p is the numeric identifier "0".
+func isZeroIdentifier(p PrereleaseIdentifier) bool {
+	return *identifierOf(p) == identifier{s: "", n: 0}
+}
+
+// identifiersEqual reports whether the pre-release identifiers a and b are
+// equal.
+func identifiersEqual(a, b PrereleaseIdentifier) bool {
+	return *identifierOf(a) == *identifierOf(b)
 }
 
 // compare returns
@@ -623,33 +1186,20 @@ func newBuild(s ...string) Build {
 // The comparison is done according to the semantic versioning specification for
 // pre-release identifiers.
 func (p Prerelease) compare(o Prerelease) int {
-	for i := range max(len(p), len(o)) {
-		var (
-			x PrereleaseIdentifier
-			y PrereleaseIdentifier
-		)
-
-		if i < len(p) {
-			x = p[i]
-		}
-
-		if i < len(o) {
-			y = o[i]
-		}
-
-		if d := comparePrereleaseIdentifiers(x, y); d != 0 {
+	for i := range min(len(p), len(o)) {
+		if d := identifierOf(p[i]).compare(identifierOf(o[i])); d != 0 {
 			return d
 		}
 	}
 
-	return 0
+	// A larger set of pre-release identifiers has a higher precedence if all
+	// of the preceding identifiers are equal.
+	return cmp.Compare(len(p), len(o))
 }
 
 // equal tells if p is equal to o.
 func (p Prerelease) equal(o Prerelease) bool {
-	return slices.EqualFunc(p, o, func(a, b PrereleaseIdentifier) bool {
-		return a.equal(b)
-	})
+	return slices.EqualFunc(p, o, identifiersEqual)
 }
 
 // equal tells if b is equal to a.
@@ -665,112 +1215,163 @@ func (b Build) equal(a Build) bool {
 //
 // The comparison is done according to the semantic versioning specification for
 // pre-release identifiers.
-func (i alphanumericIdentifier) compare(o PrereleaseIdentifier) int {
-	// Alphanumeric identifiers always have higher precedence than numeric ones.
-	if o.isNumeric() {
+func (i *identifier) compare(o *identifier) int {
+	a := i.IsAlphanumeric()
+	b := o.IsAlphanumeric()
+
+	switch {
+	case a && b:
+		if d := cmp.Compare(i.n, o.n); d != 0 || (len(i.s) <= 8 && len(o.s) <= 8) { //nolint:mnd // bytes in the prefix
+			// The prefixes differ, or they are the whole identifiers, which
+			// are equal as the identifiers do not contain zero bytes.
+			return d
+		}
+
+		return strings.Compare(i.s, o.s)
+	case a:
+		// Alphanumeric identifiers always have higher precedence than numeric
+		// ones.
 		return 1
+	case b:
+		return -1
 	}
 
-	// Now both of the identifiers must be alphanumeric.
-	j, ok := o.(alphanumericIdentifier)
-	if !ok {
-		panic(fmt.Sprintf("compared identifier should be alphanumeric: %v", o))
+	if d := cmp.Compare(i.n, o.n); d != 0 {
+		return d
 	}
 
-	return cmp.Compare(i.v, j.v)
+	// The numbers that do not fit in uint64 have their digits in s and are
+	// greater than math.MaxUint64 that has an empty s.
+	return compareDigits(i.s, o.s)
 }
 
-// equal tells if the given prereleaseIdentifier is equal to this one.
-func (i alphanumericIdentifier) equal(o PrereleaseIdentifier) bool {
-	other, ok := o.(alphanumericIdentifier)
-	if !ok {
-		return false
-	}
+// appendCore appends the core version of v to b.
+func (v *Version) appendCore(b []byte) []byte {
+	d := v.bigDigits()
 
-	return i.v == other.v
-}
+	b = appendNumber(b, v.Major, d[0])
+	b = append(b, '.')
+	b = appendNumber(b, v.Minor, d[1])
+	b = append(b, '.')
+	b = appendNumber(b, v.Patch, d[2])
 
-// isAlphanumeric reports whether this PrereleaseIdentifier is alphanumeric.
-func (i alphanumericIdentifier) isAlphanumeric() bool {
-	return true
+	return b
 }
 
-// isNumeric reports whether this PrereleaseIdentifier is numeric.
-func (i alphanumericIdentifier) isNumeric() bool {
-	return false
+// bigDigits returns the digits of the major, minor, and patch version numbers
+// of v that do not fit in uint64. The numbers that fit are empty strings.
+func (v *Version) bigDigits() [3]string {
+	if v.big == nil {
+		return [3]string{}
+	}
+
+	return [3]string{v.big.major, v.big.minor, v.big.patch}
 }
 
-// len returns the length of the pre-release identifier in characters.
-func (i alphanumericIdentifier) len() int {
-	return len(i.v)
+// setBigDigits sets the digits of the core version numbers of v that do not
+// fit in uint64.
+func (v *Version) setBigDigits(d [3]string) {
+	if d == [3]string{} {
+		v.big = nil
+
+		return
+	}
+
+	v.big = &bigCore{major: d[0], minor: d[1], patch: d[2]}
 }
 
-// compare returns
-//
-//	-1 if this identifier is less than o,
-//	 0 if this identifier equals o,
-//	+1 if this identifier is greater than o.
-//
-// The comparison is done according to the semantic versioning specification for
-// pre-release identifiers.
-func (i numericIdentifier) compare(o PrereleaseIdentifier) int {
-	// Alphanumeric identifiers always have higher precedence than numeric ones.
-	if o.isAlphanumeric() {
-		return -1
+// compareBigCore compares the core versions of v and w when at least one of
+// them has numbers that do not fit in uint64.
+func (v *Version) compareBigCore(w *Version) int {
+	a := v.bigDigits()
+	b := w.bigDigits()
+
+	if d := compareNumbers(v.Major, a[0], w.Major, b[0]); d != 0 {
+		return d
 	}
 
-	// Now both of the identifiers must be numeric.
-	j, ok := o.(numericIdentifier)
-	if !ok {
-		panic(fmt.Sprintf("compared identifier should be numeric: %v", o))
+	if d := compareNumbers(v.Minor, a[1], w.Minor, b[1]); d != 0 {
+		return d
 	}
 
-	return cmp.Compare(i.v, j.v)
+	return compareNumbers(v.Patch, a[2], w.Patch, b[2])
 }
 
-// equal tells if the given prereleaseIdentifier is equal to this one.
-func (i numericIdentifier) equal(o PrereleaseIdentifier) bool {
-	other, ok := o.(numericIdentifier)
-	if !ok {
-		return false
+// appendNumber appends the number u to b, or the digits if the number does not
+// fit in uint64.
+func appendNumber(b []byte, u uint64, digits string) []byte {
+	if digits != "" {
+		return append(b, digits...)
 	}
 
-	return i.v == other.v
+	return strconv.AppendUint(b, u, 10)
 }
 
-// isAlphanumeric reports whether this PrereleaseIdentifier is alphanumeric.
-func (i numericIdentifier) isAlphanumeric() bool {
-	return false
+// compareDigits compares two numbers given as digits without leading zeros.
+func compareDigits(a, b string) int {
+	if d := cmp.Compare(len(a), len(b)); d != 0 {
+		return d
+	}
+
+	return cmp.Compare(a, b)
 }
 
-// isNumeric reports whether this PrereleaseIdentifier is numeric.
-func (i numericIdentifier) isNumeric() bool {
-	return true
+// parseUint parses the digits in s into a number and reports whether it fits in
+// uint64.
+func parseUint[T ~string | ~[]byte](s T) (uint64, bool) {
+	var u uint64
+
+	for i := range len(s) {
+		d := uint64(s[i] - '0')
+		if u > (math.MaxUint64-d)/10 { //nolint:mnd // decimal base
+			return 0, false
+		}
+
+		u = u*10 + d //nolint:mnd // decimal base
+	}
+
+	return u, true
 }
 
-// len returns the length of the pre-release identifier in characters.
-func (i numericIdentifier) len() int {
-	return countDigits(i.v)
+// trimLeadingZeros removes the leading zeros from the digits in s, leaving at
+// least one digit.
+func trimLeadingZeros[T ~string | ~[]byte](s T) T {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+
+	return s
 }
 
-func comparePrereleaseIdentifiers(x, y PrereleaseIdentifier) int {
-	if x == y {
-		return 0
+// isBigNumber tells if s is a number without leading zeros that does not fit
+// in uint64.
+func isBigNumber(s string) bool {
+	if !overflowsUint64(s) {
+		return false
 	}
 
-	if x == nil {
-		if y != nil {
-			return -1
+	for i := range len(s) {
+		if !isDigit(s[i]) {
+			return false
 		}
-
-		return 1
 	}
 
-	if y == nil {
+	return s[0] != '0'
+}
+
+// compareNumbers compares two numbers that are given either as uint64 or, if
+// they do not fit in uint64, as their digits.
+func compareNumbers(u uint64, a string, w uint64, b string) int {
+	switch {
+	case a == "" && b == "":
+		return cmp.Compare(u, w)
+	case a == "":
+		return -1
+	case b == "":
 		return 1
+	default:
+		return compareDigits(a, b)
 	}
-
-	return x.compare(y)
 }
 
 func countDigits(u uint64) int {
@@ -788,16 +1389,6 @@ func countDigits(u uint64) int {
 	return count
 }
 
-func isAlphanumericIdentifier(s string) bool {
-	for i := range len(s) {
-		if !isIdentifierCharacter(s[i]) {
-			return false
-		}
-	}
-
-	return true
-}
-
 func isASCII(s string) bool {
 	for i := range len(s) {
 		if s[i] > unicode.MaxASCII {
@@ -827,50 +1418,69 @@ func isNumericIdentifier(s string) bool {
 }
 
 func parseBuild(s string) ([]string, error) {
+	return appendBuild(nil, s)
+}
+
+// appendBuild parses the build identifiers in s and appends them to b.
+func appendBuild(b Build, s string) (Build, error) {
 	if s == "" {
-		return nil, fmt.Errorf("%w: cannot parse empty string as a build", ErrInvalidVersion)
+		return nil, newParseError(s, 0, 0, KindEmptyIdentifier, ComponentBuild)
 	}
 
-	result := strings.Split(s, ".")
-	for _, v := range result {
-		if v == "" {
-			return nil, fmt.Errorf("%w: empty string as a build identifier", ErrInvalidVersion)
+	b = slices.Grow(b, strings.Count(s, ".")+1)
+
+	for offset := 0; ; {
+		end := strings.IndexByte(s[offset:], '.')
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += offset
 		}
 
-		// This should be safe as all of the characters in the version must be
-		// ASCII.
-		if !isAlphanumericIdentifier(v) {
-			return nil, fmt.Errorf(
-				"%w: invalid byte in the build identifier %q",
-				ErrInvalidVersion,
-				v,
-			)
+		if end == offset {
+			return nil, newParseError(s, offset, 0, KindEmptyIdentifier, ComponentBuild)
+		}
+
+		for i := offset; i < end; i++ {
+			if !isIdentifierCharacter(s[i]) {
+				return nil, newParseError(s, i, 1, KindInvalidCharacter, ComponentBuild)
+			}
 		}
-	}
 
-	return result, nil
+		b = append(b, s[offset:end])
+
+		if end == len(s) {
+			return b, nil
+		}
+
+		offset = end + 1
+	}
 }
 
-// stripPrefix parses the possible "v" prefix for the version string.
-// The function returns the new position where the parsing continues.
-func stripPrefix(s string) (int, error) {
+// stripPrefix parses the possible prefix for the version string. The longest
+// of the given prefixes that s starts with is removed, and if fold is true,
+// the prefixes are matched case-insensitively. The function returns the new
+// position where the parsing continues.
+func stripPrefix[T ~string | ~[]byte](s T, prefixes []string, fold bool) (int, error) {
 	pos := 0
 
-	c := s[0]
-	if !isDigit(c) && c != 'v' {
-		return pos, fmt.Errorf(
-			"%w: version %q does not start with a digit or 'v'",
-			ErrInvalidVersion,
-			s,
-		)
+	for _, prefix := range prefixes {
+		if len(prefix) <= pos || len(prefix) > len(s) {
+			continue
+		}
+
+		head := string(s[:len(prefix)])
+		if head == prefix || (fold && strings.EqualFold(head, prefix)) {
+			pos = len(prefix)
+		}
 	}
 
-	if c == 'v' {
-		pos++
+	if pos == 0 && !isDigit(s[0]) {
+		return pos, newParseError(string(s), 0, 1, KindBadPrefix, ComponentPrefix)
 	}
 
 	if pos == len(s) {
-		return pos, fmt.Errorf("%w: %q", ErrInvalidVersion, s)
+		return pos, newParseError(string(s), pos, 0, KindEmptyIdentifier, ComponentCore)
 	}
 
 	return pos, nil
@@ -21,8 +21,11 @@ package semver
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
@@ -780,6 +783,41 @@ func init() {
 	}
 }
 
+func BenchmarkCompare(b *testing.B) {
+	v := MustParse("1.2.3-alpha.beta.11.rc-1")
+	w := MustParse("1.2.3-alpha.beta.11.rc-2")
+
+	for b.Loop() {
+		_ = v.Compare(w)
+	}
+}
+
+func BenchmarkCompareNumeric(b *testing.B) {
+	v := MustParse("1.2.3-0.3.7")
+	w := MustParse("1.2.3-0.3.8")
+
+	for b.Loop() {
+		_ = v.Compare(w)
+	}
+}
+
+func BenchmarkVersionsSort(b *testing.B) {
+	pre := []string{"", "-alpha", "-alpha.1", "-alpha.beta", "-beta.2", "-beta.11", "-rc.1", "-0.3.7"}
+	versions := make(Versions, 0, 10000)
+
+	for i := range cap(versions) {
+		s := fmt.Sprintf("%d.%d.%d%s", i%7, i%5, i%3, pre[i%len(pre)])
+		versions = append(versions, MustParse(s))
+	}
+
+	x := make(Versions, len(versions))
+
+	for b.Loop() {
+		copy(x, versions)
+		sort.Sort(x)
+	}
+}
+
 func BenchmarkIsValidByParse(b *testing.B) {
 	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
 
@@ -796,6 +834,50 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkParseBytes(b *testing.B) {
+	test := []byte("0.1.0-alpha.24+sha.19031c2.darwin.amd64")
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = ParseBytes(test)
+	}
+}
+
+func BenchmarkParseBytesCore(b *testing.B) {
+	test := []byte("10.20.30")
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = ParseBytes(test)
+	}
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
+
+	var v Version
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = v.ParseInto(test)
+	}
+}
+
+func BenchmarkParseIntoCore(b *testing.B) {
+	test := "10.20.30"
+
+	var v Version
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = v.ParseInto(test)
+	}
+}
+
 func BenchmarkParseLax(b *testing.B) {
 	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
 
@@ -1596,6 +1678,215 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseBytes(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range parserTests {
+		name := tt.v
+		if name == "" {
+			name = emptyName
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := []byte(tt.v)
+			got, gotErr := ParseBytes(b)
+
+			if tt.wantErr {
+				if gotErr == nil {
+					t.Fatalf("ParseBytes(%q) succeeded unexpectedly; got %v, want error", tt.v, got)
+				}
+
+				_, err := Parse(tt.v)
+				if gotErr.Error() != err.Error() {
+					t.Errorf("ParseBytes(%q) error = %v, want %v", tt.v, gotErr, err)
+				}
+
+				return
+			}
+
+			if gotErr != nil {
+				t.Fatalf("ParseBytes(%q) failed unexpectedly: %v", tt.v, gotErr)
+			}
+
+			// The returned version must not refer to the input.
+			for i := range b {
+				b[i] = 'x'
+			}
+
+			if !tt.want.StrictEqual(got) {
+				t.Errorf("ParseBytes(%q) = %v, want %v (strictly equal)", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInto(t *testing.T) {
+	t.Parallel()
+
+	// The same version is reused for all of the inputs to check that no state
+	// leaks from the previous versions.
+	var v Version
+
+	for _, tt := range parserTests {
+		gotErr := v.ParseInto(tt.v)
+
+		if tt.wantErr {
+			if gotErr == nil {
+				t.Errorf("ParseInto(%q) succeeded unexpectedly; got %v, want error", tt.v, &v)
+			}
+
+			continue
+		}
+
+		if gotErr != nil {
+			t.Errorf("ParseInto(%q) failed unexpectedly: %v", tt.v, gotErr)
+
+			continue
+		}
+
+		if !tt.want.StrictEqual(&v) {
+			t.Errorf("ParseInto(%q) = %v, want %v (strictly equal)", tt.v, &v, tt.want)
+		}
+
+		if v.String() != tt.want.String() {
+			t.Errorf("ParseInto(%q).String() = %q, want %q", tt.v, v.String(), tt.want.String())
+		}
+	}
+}
+
+func TestParseIntoAllocs(t *testing.T) {
+	tests := []struct {
+		v    string
+		want float64
+	}{
+		{"10.20.30", 0},
+		{"v1.2.3", 0},
+		{"1.2.3-0.3.7+build.123", 0},
+		{"0.1.0-alpha.24+sha.19031c2.darwin.amd64", 0},
+	}
+
+	for _, tt := range tests {
+		var v Version
+
+		if n := testing.AllocsPerRun(100, func() { _ = v.ParseInto(tt.v) }); n != tt.want {
+			t.Errorf("ParseInto(%q) allocated %v times, want %v", tt.v, n, tt.want)
+		}
+	}
+
+	b := []byte("10.20.30")
+	if n := testing.AllocsPerRun(100, func() { _, _ = ParseBytes(b) }); n != 1 {
+		t.Errorf("ParseBytes(%q) allocated %v times, want 1", b, n)
+	}
+}
+
+func TestParseBytesInto(t *testing.T) {
+	t.Parallel()
+
+	// The same version is reused for all of the inputs to check that no state
+	// leaks from the previous versions.
+	var v Version
+
+	for _, tt := range parserTests {
+		gotErr := v.ParseBytesInto([]byte(tt.v))
+
+		if tt.wantErr {
+			if gotErr == nil {
+				t.Errorf("ParseBytesInto(%q) succeeded unexpectedly; got %v, want error", tt.v, &v)
+			}
+
+			continue
+		}
+
+		if gotErr != nil {
+			t.Errorf("ParseBytesInto(%q) failed unexpectedly: %v", tt.v, gotErr)
+
+			continue
+		}
+
+		if !tt.want.StrictEqual(&v) {
+			t.Errorf("ParseBytesInto(%q) = %v, want %v (strictly equal)", tt.v, &v, tt.want)
+		}
+	}
+}
+
+func TestParseBytesIntoAllocs(t *testing.T) {
+	tests := []struct {
+		v    string
+		want float64
+	}{
+		{"10.20.30", 0},
+		{"v1.2.3", 0},
+		{"1.2.3-0.3.7+build.123", 1},
+		{"0.1.0-alpha.24+sha.19031c2.darwin.amd64", 1},
+	}
+
+	for _, tt := range tests {
+		var v Version
+
+		b := []byte(tt.v)
+		if n := testing.AllocsPerRun(100, func() { _ = v.ParseBytesInto(b) }); n != tt.want {
+			t.Errorf("ParseBytesInto(%q) allocated %v times, want %v", tt.v, n, tt.want)
+		}
+	}
+
+	// The Version, the string for the identifiers, and the build identifiers.
+	b := []byte("1.2.3-alpha.1+build.5")
+	if n := testing.AllocsPerRun(100, func() { _, _ = ParseBytes(b) }); n != 3 {
+		t.Errorf("ParseBytes(%q) allocated %v times, want 3", b, n)
+	}
+}
+
+func TestParsePrereleaseStorage(t *testing.T) {
+	t.Parallel()
+
+	v := MustParse("1.2.3-a")
+	x := append(v.Prerelease, NumericIdentifier(9))
+	y := append(v.Prerelease, NumericIdentifier(7))
+
+	if x.String() != "a.9" || y.String() != "a.7" || v.Prerelease.String() != "a" {
+		t.Errorf("appending to the Prerelease of %v gave %q and %q", v, x, y)
+	}
+
+	w, err := ParseBytes([]byte("1.2.3-a.b"))
+	if err != nil {
+		t.Fatalf("ParseBytes(%q) failed unexpectedly: %v", "1.2.3-a.b", err)
+	}
+
+	x = append(w.Prerelease, NumericIdentifier(9))
+	_ = append(w.Prerelease, NumericIdentifier(7))
+
+	if x.String() != "a.b.9" {
+		t.Errorf("appending to the Prerelease of %v gave %q", w, x)
+	}
+
+	// A hyphen in the prefix does not make the parser allocate the storage for
+	// pre-release identifiers.
+	u, err := (&Parser{Prefixes: []string{"release-"}}).Parse("release-1.2.3")
+	if err != nil {
+		t.Fatalf("Parse(%q) failed unexpectedly: %v", "release-1.2.3", err)
+	}
+
+	if u.Prerelease != nil || u.ids != nil {
+		t.Errorf("Parse(%q) has pre-release storage %v, %v", "release-1.2.3", u.Prerelease, u.ids)
+	}
+
+	// Reusing a Version does not change the identifiers that it did not parse.
+	var r Version
+
+	p := MustParse("1.0.0-rc.1").Prerelease
+	r.Prerelease = slices.Clone(p)
+
+	if err := r.ParseInto("2.0.0-beta.2"); err != nil {
+		t.Fatalf("ParseInto(%q) failed unexpectedly: %v", "2.0.0-beta.2", err)
+	}
+
+	if p.String() != "rc.1" || r.Prerelease.String() != "beta.2" {
+		t.Errorf("ParseInto(%q) into a shared Prerelease gave %q and %q", "2.0.0-beta.2", p, r.Prerelease)
+	}
+}
+
 func TestParseLax(t *testing.T) {
 	t.Parallel()
 
@@ -1893,7 +2184,7 @@ func isValidByParse(s string) bool {
 }
 
 func newTestPrerelease(a ...any) Prerelease {
-	p, err := newPrerelease(a...)
+	p, err := NewPrerelease(a...)
 	if err != nil {
 		panic(err)
 	}
@@ -1902,12 +2193,17 @@ func newTestPrerelease(a ...any) Prerelease {
 }
 
 func newVersion(major, minor, patch uint64, pr Prerelease, b ...string) *Version {
+	build, err := NewBuild(b...)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Version{
 		Major:      major,
 		Minor:      minor,
 		Patch:      patch,
 		Prerelease: pr,
-		Build:      newBuild(b...),
+		Build:      build,
 	}
 }
 
@@ -19,8 +19,15 @@
 
 package semver
 
+import (
+	"iter"
+	"slices"
+)
+
 // Versions attaches the methods of [sort.Interface] to a version slice, sorting
-// in increasing order.
+// in increasing order. It also has helpers for sorting, searching, and
+// iterating the versions that use [Version.Compare] for ordering. The helpers
+// that search the slice require it to be sorted in increasing order.
 type Versions []*Version
 
 // Len is the number of elements in Versions.
@@ -49,3 +56,95 @@ func (x Versions) Less(i, j int) bool {
 func (x Versions) Swap(i, j int) {
 	x[i], x[j] = x[j], x[i]
 }
+
+// Sort sorts x in increasing order.
+func (x Versions) Sort() {
+	slices.SortFunc(x, Compare)
+}
+
+// SortStable sorts x in increasing order while keeping the original order of
+// equal versions, for example versions that differ only by their build
+// metadata.
+func (x Versions) SortStable() {
+	slices.SortStableFunc(x, Compare)
+}
+
+// Max returns the greatest version in x. If there are several greatest
+// versions, Max returns the first one. It returns nil if x is empty.
+func (x Versions) Max() *Version {
+	if len(x) == 0 {
+		return nil
+	}
+
+	return slices.MaxFunc(x, Compare)
+}
+
+// Min returns the least version in x. If there are several least versions,
+// Min returns the first one. It returns nil if x is empty.
+func (x Versions) Min() *Version {
+	if len(x) == 0 {
+		return nil
+	}
+
+	return slices.MinFunc(x, Compare)
+}
+
+// BinarySearch searches for v in x, which must be sorted in increasing order.
+// It returns the position where v is found, or the position where v would
+// appear in the sort order, and reports whether v is in x.
+func (x Versions) BinarySearch(v *Version) (int, bool) {
+	return slices.BinarySearchFunc(x, v, Compare)
+}
+
+// Compact replaces consecutive runs of equal versions, as reported by
+// [Version.Equal], with the first version of the run and returns the shortened
+// slice. If x is sorted, the returned slice has no duplicate versions. Like
+// [slices.Compact], Compact modifies the contents of x and zeroes the elements
+// between the new length and the original length.
+func (x Versions) Compact() Versions {
+	return slices.CompactFunc(x, (*Version).Equal)
+}
+
+// Floor returns the greatest version in x that is less than or equal to v, or
+// nil if there is no such version. The slice must be sorted in increasing
+// order.
+func (x Versions) Floor(v *Version) *Version {
+	i, found := x.BinarySearch(v)
+	if found {
+		return x[i]
+	}
+
+	if i == 0 {
+		return nil
+	}
+
+	return x[i-1]
+}
+
+// Ceiling returns the least version in x that is greater than or equal to v,
+// or nil if there is no such version. The slice must be sorted in increasing
+// order.
+func (x Versions) Ceiling(v *Version) *Version {
+	i, _ := x.BinarySearch(v)
+	if i == len(x) {
+		return nil
+	}
+
+	return x[i]
+}
+
+// All returns an iterator over the versions in x in order.
+func (x Versions) All() iter.Seq[*Version] {
+	return slices.Values(x)
+}
+
+// Backward returns an iterator over the versions in x in reverse order.
+func (x Versions) Backward() iter.Seq[*Version] {
+	return func(yield func(*Version) bool) {
+		for i := len(x) - 1; i >= 0; i-- {
+			if !yield(x[i]) {
+				return
+			}
+		}
+	}
+}
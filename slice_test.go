@@ -21,6 +21,7 @@ package semver_test
 
 import (
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"testing"
@@ -164,3 +165,162 @@ func TestVersionsSort(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionsSortMethods(t *testing.T) {
+	t.Parallel()
+
+	input := []string{"1.0.0+b", "1.0.0-rc.1", "2.0.0", "1.0.0+a", "0.9.0", "1.0.0"}
+
+	x := newVersions(t, input...)
+	x.Sort()
+
+	if got, want := versionStrings(x), []string{"0.9.0", "1.0.0-rc.1"}; !reflect.DeepEqual(got[:2], want) {
+		t.Errorf("Versions.Sort() = %v, want prefix %v", got, want)
+	}
+
+	if got := x[len(x)-1].String(); got != "2.0.0" {
+		t.Errorf("Versions.Sort() last = %q, want %q", got, "2.0.0")
+	}
+
+	x = newVersions(t, input...)
+	x.SortStable()
+
+	want := []string{"0.9.0", "1.0.0-rc.1", "1.0.0+b", "1.0.0+a", "1.0.0", "2.0.0"}
+	if got := versionStrings(x); !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions.SortStable() = %v, want %v", got, want)
+	}
+}
+
+func TestVersionsMaxMin(t *testing.T) {
+	t.Parallel()
+
+	x := newVersions(t, "1.0.0", "2.0.0-rc.1", "1.0.0-alpha", "2.0.0-beta", "1.0.0-alpha+b")
+
+	if got := x.Max().String(); got != "2.0.0-rc.1" {
+		t.Errorf("Versions.Max() = %q, want %q", got, "2.0.0-rc.1")
+	}
+
+	if got := x.Min().String(); got != "1.0.0-alpha" {
+		t.Errorf("Versions.Min() = %q, want %q", got, "1.0.0-alpha")
+	}
+
+	if got := (semver.Versions{}).Max(); got != nil {
+		t.Errorf("Versions{}.Max() = %v, want nil", got)
+	}
+
+	if got := (semver.Versions{}).Min(); got != nil {
+		t.Errorf("Versions{}.Min() = %v, want nil", got)
+	}
+}
+
+func TestVersionsSearch(t *testing.T) {
+	t.Parallel()
+
+	x := newVersions(t, "1.0.0-rc.1", "1.0.0", "1.2.0", "2.0.0")
+
+	tests := []struct {
+		v       string
+		i       int
+		found   bool
+		floor   string
+		ceiling string
+	}{
+		{"0.1.0", 0, false, "", "1.0.0-rc.1"},
+		{"1.0.0-rc.1", 0, true, "1.0.0-rc.1", "1.0.0-rc.1"},
+		{"1.0.0-rc.2", 1, false, "1.0.0-rc.1", "1.0.0"},
+		{"1.0.0+build", 1, true, "1.0.0", "1.0.0"},
+		{"1.1.0", 2, false, "1.0.0", "1.2.0"},
+		{"2.0.0", 3, true, "2.0.0", "2.0.0"},
+		{"3.0.0", 4, false, "2.0.0", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			t.Parallel()
+
+			v := semver.MustParse(tt.v)
+
+			i, found := x.BinarySearch(v)
+			if i != tt.i || found != tt.found {
+				t.Errorf("Versions.BinarySearch(%q) = %d, %t, want %d, %t", tt.v, i, found, tt.i, tt.found)
+			}
+
+			if got := versionString(x.Floor(v)); got != tt.floor {
+				t.Errorf("Versions.Floor(%q) = %q, want %q", tt.v, got, tt.floor)
+			}
+
+			if got := versionString(x.Ceiling(v)); got != tt.ceiling {
+				t.Errorf("Versions.Ceiling(%q) = %q, want %q", tt.v, got, tt.ceiling)
+			}
+		})
+	}
+}
+
+func TestVersionsCompact(t *testing.T) {
+	t.Parallel()
+
+	x := newVersions(t, "1.0.0", "1.0.0+a", "1.1.0", "1.1.0", "1.1.0-rc.1", "1.1.0")
+
+	want := []string{"1.0.0", "1.1.0", "1.1.0-rc.1", "1.1.0"}
+	if got := versionStrings(x.Compact()); !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions.Compact() = %v, want %v", got, want)
+	}
+}
+
+func TestVersionsIterators(t *testing.T) {
+	t.Parallel()
+
+	x := newVersions(t, "1.0.0", "1.1.0", "2.0.0")
+
+	if got, want := versionStrings(slices.Collect(x.All())), versionStrings(x); !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions.All() = %v, want %v", got, want)
+	}
+
+	want := []string{"2.0.0", "1.1.0", "1.0.0"}
+	if got := versionStrings(slices.Collect(x.Backward())); !reflect.DeepEqual(got, want) {
+		t.Errorf("Versions.Backward() = %v, want %v", got, want)
+	}
+
+	for v := range x.Backward() {
+		if v.String() != "2.0.0" {
+			t.Errorf("Versions.Backward() first = %q, want %q", v, "2.0.0")
+		}
+
+		break
+	}
+}
+
+func newVersions(t *testing.T, a ...string) semver.Versions {
+	t.Helper()
+
+	x := make(semver.Versions, len(a))
+
+	for i, s := range a {
+		v, err := semver.Parse(s)
+		if err != nil {
+			t.Fatalf("Setup error: Parse(%q) failed: %v", s, err)
+		}
+
+		x[i] = v
+	}
+
+	return x
+}
+
+func versionString(v *semver.Version) string {
+	if v == nil {
+		return ""
+	}
+
+	return v.String()
+}
+
+func versionStrings[S ~[]*semver.Version](x S) []string {
+	a := make([]string, len(x))
+
+	for i, v := range x {
+		a[i] = v.String()
+	}
+
+	return a
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// Markers used in the sortable encoding of versions.
+const (
+	// sortableEnd ends the pre-release identifiers in the sortable encoding.
+	sortableEnd byte = 0x00
+
+	// sortablePrerelease marks that the version has pre-release identifiers.
+	sortablePrerelease byte = 0x01
+
+	// sortableRelease marks that the version has no pre-release identifiers.
+	// It is greater than sortablePrerelease as a version without pre-release
+	// identifiers has higher precedence.
+	sortableRelease byte = 0x02
+
+	// sortableNumeric is the tag of a numeric pre-release identifier.
+	sortableNumeric byte = 0x01
+
+	// sortableAlphanumeric is the tag of an alphanumeric pre-release
+	// identifier. It is greater than sortableNumeric as alphanumeric
+	// identifiers have higher precedence.
+	sortableAlphanumeric byte = 0x02
+
+	// sortableBig is the length byte of a number that does not fit in uint64.
+	// It is greater than the length of any uint64 so that such numbers sort
+	// after all of the numbers that fit in uint64.
+	sortableBig byte = 0x09
+)
+
+// A SortableVersion is a [Version] that is stored in a database using
+// the sortable binary encoding of [Version.AppendSortable] instead of
+// the version string. It should be stored in a binary column, like BLOB in
+// SQLite or bytea in PostgreSQL, so that ordering by the column sorts
+// the versions according to the semantic versioning specification.
+type SortableVersion struct {
+	Version
+}
+
+// AppendSortable appends the sortable binary encoding of v to b. Comparing
+// the encodings of two versions byte by byte, for example using [bytes.Compare]
+// or in the index of a database, gives the same result as [Version.Compare]
+// when the versions are not equal. The build metadata is stored at the end of
+// the encoding, so versions that are equal according to [Version.Compare] are
+// ordered by their build metadata.
+//
+// The encoding can be decoded using [ParseSortable].
+func (v *Version) AppendSortable(b []byte) []byte {
+	d := v.bigDigits()
+
+	b = appendSortableNumber(b, v.Major, d[0])
+	b = appendSortableNumber(b, v.Minor, d[1])
+	b = appendSortableNumber(b, v.Patch, d[2])
+
+	if len(v.Prerelease) == 0 {
+		b = append(b, sortableRelease)
+	} else {
+		b = append(b, sortablePrerelease)
+
+		for _, ident := range v.Prerelease {
+			if id := identifierOf(ident); id.IsAlphanumeric() {
+				b = append(b, sortableAlphanumeric)
+				b = append(b, id.s...)
+				b = append(b, sortableEnd)
+			} else {
+				b = append(b, sortableNumeric)
+				b = appendSortableNumber(b, id.n, id.s)
+			}
+		}
+
+		b = append(b, sortableEnd)
+	}
+
+	b, _ = v.Build.AppendText(b)
+
+	return b
+}
+
+// ParseSortable parses a version from the sortable binary encoding created by
+// [Version.AppendSortable].
+func ParseSortable(b []byte) (*Version, error) {
+	var (
+		v   Version
+		big [3]string
+		err error
+	)
+
+	if v.Major, big[0], b, err = readSortableNumber(b); err != nil {
+		return nil, err
+	}
+
+	if v.Minor, big[1], b, err = readSortableNumber(b); err != nil {
+		return nil, err
+	}
+
+	if v.Patch, big[2], b, err = readSortableNumber(b); err != nil {
+		return nil, err
+	}
+
+	v.setBigDigits(big)
+
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%w: missing pre-release marker in sortable encoding", ErrInvalidVersion)
+	}
+
+	switch b[0] {
+	case sortableRelease:
+		b = b[1:]
+	case sortablePrerelease:
+		if v.Prerelease, b, err = readSortablePrerelease(b[1:]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf(
+			"%w: invalid pre-release marker %#x in sortable encoding",
+			ErrInvalidVersion,
+			b[0],
+		)
+	}
+
+	if len(b) > 0 {
+		if v.Build, err = parseBuild(string(b)); err != nil {
+			return nil, fmt.Errorf("failed to parse the build identifiers: %w", err)
+		}
+	}
+
+	return &v, nil
+}
+
+// appendSortableUint appends the sortable encoding of u to b. The number is
+// written as a byte that holds the length of the number in bytes followed by
+// the minimal big-endian representation of it, so that shorter numbers sort
+// first.
+func appendSortableUint(b []byte, u uint64) []byte {
+	n := (bits.Len64(u) + 7) / 8 //nolint:mnd // bits to bytes
+
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], u)
+
+	b = append(b, byte(n))
+
+	return append(b, buf[8-n:]...)
+}
+
+// appendSortableNumber appends the sortable encoding of a number to b. If
+// the number does not fit in uint64, its digits are given in digits and it is
+// written as sortableBig followed by the number of digits as a 32-bit
+// big-endian integer and the digits.
+func appendSortableNumber(b []byte, u uint64, digits string) []byte {
+	if digits == "" {
+		return appendSortableUint(b, u)
+	}
+
+	b = append(b, sortableBig)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(digits))) //nolint:gosec // digits come from a string
+
+	return append(b, digits...)
+}
+
+// readSortableNumber reads a number in the sortable encoding from the start of
+// b. It returns the number, its digits if it does not fit in uint64, and
+// the rest of b.
+func readSortableNumber(b []byte) (uint64, string, []byte, error) {
+	if len(b) == 0 || b[0] != sortableBig {
+		u, rest, err := readSortableUint(b)
+
+		return u, "", rest, err
+	}
+
+	const lenSize = 4 // size of the digit count
+
+	if len(b) < 1+lenSize {
+		return 0, "", nil, fmt.Errorf("%w: invalid number in sortable encoding", ErrInvalidVersion)
+	}
+
+	n := int(binary.BigEndian.Uint32(b[1 : 1+lenSize]))
+	b = b[1+lenSize:]
+
+	if len(b) < n {
+		return 0, "", nil, fmt.Errorf("%w: invalid number in sortable encoding", ErrInvalidVersion)
+	}
+
+	digits := string(b[:n])
+	if !isBigNumber(digits) {
+		return 0, "", nil, fmt.Errorf("%w: invalid number in sortable encoding", ErrInvalidVersion)
+	}
+
+	return math.MaxUint64, digits, b[n:], nil
+}
+
+// readSortableUint reads a number in the sortable encoding from the start of
+// b and returns it and the rest of b.
+func readSortableUint(b []byte) (uint64, []byte, error) {
+	if len(b) == 0 {
+		return 0, nil, fmt.Errorf("%w: missing number in sortable encoding", ErrInvalidVersion)
+	}
+
+	n := int(b[0])
+	if n > 8 || len(b) < n+1 || (n > 0 && b[1] == 0) { //nolint:mnd // max bytes in uint64
+		return 0, nil, fmt.Errorf("%w: invalid number in sortable encoding", ErrInvalidVersion)
+	}
+
+	var buf [8]byte
+	copy(buf[8-n:], b[1:n+1])
+
+	return binary.BigEndian.Uint64(buf[:]), b[n+1:], nil
+}
+
+// readSortablePrerelease reads the pre-release identifiers in the sortable
+// encoding from the start of b and returns them and the rest of b.
+func readSortablePrerelease(b []byte) (Prerelease, []byte, error) {
+	var p Prerelease
+
+	for len(b) > 0 {
+		switch b[0] {
+		case sortableEnd:
+			if len(p) == 0 {
+				return nil, nil, fmt.Errorf(
+					"%w: empty pre-release in sortable encoding",
+					ErrInvalidVersion,
+				)
+			}
+
+			return p, b[1:], nil
+		case sortableNumeric:
+			u, digits, rest, err := readSortableNumber(b[1:])
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if digits != "" {
+				p = append(p, bigNumericIdentifier(digits))
+			} else {
+				p = append(p, NumericIdentifier(u))
+			}
+
+			b = rest
+		case sortableAlphanumeric:
+			i := 1
+			for i < len(b) && b[i] != sortableEnd {
+				i++
+			}
+
+			if i == len(b) {
+				return nil, nil, fmt.Errorf(
+					"%w: unterminated pre-release identifier in sortable encoding",
+					ErrInvalidVersion,
+				)
+			}
+
+			ident, err := parsePrereleaseIdentifier(string(b[1:i]), false)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid pre-release in sortable encoding: %w", err)
+			}
+
+			if !ident.IsAlphanumeric() {
+				return nil, nil, fmt.Errorf(
+					"%w: numeric identifier stored as alphanumeric in sortable encoding",
+					ErrInvalidVersion,
+				)
+			}
+
+			p = append(p, ident)
+			b = b[i+1:]
+		default:
+			return nil, nil, fmt.Errorf(
+				"%w: invalid pre-release identifier tag %#x in sortable encoding",
+				ErrInvalidVersion,
+				b[0],
+			)
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: unterminated pre-release in sortable encoding", ErrInvalidVersion)
+}
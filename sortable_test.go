@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+var sortableVersions = []string{
+	"0.0.0-0",
+	"0.0.0",
+	"0.0.1",
+	"0.1.0-alpha",
+	"1.0.0-0",
+	"1.0.0-0.0",
+	"1.0.0-1",
+	"1.0.0-2",
+	"1.0.0-10",
+	"1.0.0-256",
+	"1.0.0--",
+	"1.0.0-a",
+	"1.0.0-alpha",
+	"1.0.0-alpha.1",
+	"1.0.0-alpha.beta",
+	"1.0.0-alpha0",
+	"1.0.0-beta",
+	"1.0.0-beta.2",
+	"1.0.0-beta.11",
+	"1.0.0-rc.1",
+	"1.0.0",
+	"1.0.0+build.1",
+	"1.0.1",
+	"1.2.0",
+	"1.10.0",
+	"2.0.0-beta+exp.sha.5114f85",
+	"9.0.0",
+	"10.0.0",
+	"255.0.0",
+	"256.0.0",
+	"18446744073709551615.0.0",
+}
+
+func TestVersionAppendSortable(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range sortableVersions {
+		for _, y := range sortableVersions {
+			v := semver.MustParse(x)
+			w := semver.MustParse(y)
+
+			want := v.Compare(w)
+			if want == 0 {
+				continue
+			}
+
+			got := bytes.Compare(v.AppendSortable(nil), w.AppendSortable(nil))
+			if got != want {
+				t.Errorf(
+					"bytes.Compare(AppendSortable(%q), AppendSortable(%q)) = %d, want %d",
+					x,
+					y,
+					got,
+					want,
+				)
+			}
+		}
+	}
+}
+
+func TestParseSortable(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range sortableVersions {
+		t.Run(s, func(t *testing.T) {
+			t.Parallel()
+
+			want := semver.MustParse(s)
+
+			got, err := semver.ParseSortable(want.AppendSortable(nil))
+			if err != nil {
+				t.Fatalf("ParseSortable(AppendSortable(%q)) failed unexpectedly: %v", s, err)
+			}
+
+			if !got.StrictEqual(want) {
+				t.Errorf("ParseSortable(AppendSortable(%q)) = %v, want %v", s, got, want)
+			}
+		})
+	}
+}
+
+func TestParseSortableError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"empty", nil},
+		{"missing-minor", []byte{1, 1}},
+		{"missing-marker", []byte{1, 1, 0, 0}},
+		{"invalid-marker", []byte{1, 1, 0, 0, 3}},
+		{"leading-zero-byte", []byte{2, 0, 1, 0, 0, 2}},
+		{"too-long-number", []byte{9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 2}},
+		{"empty-prerelease", []byte{1, 1, 0, 0, 1, 0}},
+		{"unterminated-prerelease", []byte{1, 1, 0, 0, 1, 1, 0}},
+		{"unterminated-identifier", []byte{1, 1, 0, 0, 1, 2, 'a'}},
+		{"numeric-as-alphanumeric", []byte{1, 1, 0, 0, 1, 2, '1', 0, 0}},
+		{"invalid-build", []byte{1, 1, 0, 0, 2, '_'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := semver.ParseSortable(tt.b)
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Errorf("ParseSortable(%v) = %v, %v, want ErrInvalidVersion", tt.b, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan implements the [database/sql.Scanner] interface. The value from
+// the database must be a version string, and it is parsed using [Parse].
+// NULL, which [Version.Value] stores for a nil Version, sets v to the zero
+// Version. To tell NULL apart from "0.0.0", scan into a *Version variable,
+// which [database/sql] sets to nil for NULL.
+func (v *Version) Scan(src any) error {
+	var s string
+
+	switch x := src.(type) {
+	case nil:
+		*v = Version{}
+
+		return nil
+	case string:
+		s = x
+	case []byte:
+		s = string(x)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Version", ErrInvalidVersion, src)
+	}
+
+	w, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*v = *w
+
+	return nil
+}
+
+// Value implements the [database/sql/driver.Valuer] interface. The version is
+// stored as the string returned by [Version.String]. A nil Version is stored as
+// NULL.
+func (v *Version) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	return v.String(), nil
+}
+
+// Scan implements the [database/sql.Scanner] interface. The value from
+// the database must be in the sortable binary encoding, and it is parsed using
+// [ParseSortable]. NULL sets v to the zero SortableVersion like in
+// [Version.Scan].
+func (v *SortableVersion) Scan(src any) error {
+	var b []byte
+
+	switch x := src.(type) {
+	case nil:
+		*v = SortableVersion{}
+
+		return nil
+	case []byte:
+		b = x
+	case string:
+		b = []byte(x)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into SortableVersion", ErrInvalidVersion, src)
+	}
+
+	w, err := ParseSortable(b)
+	if err != nil {
+		return err
+	}
+
+	v.Version = *w
+
+	return nil
+}
+
+// Value implements the [database/sql/driver.Valuer] interface. The version is
+// stored in the sortable binary encoding created by [Version.AppendSortable].
+func (v SortableVersion) Value() (driver.Value, error) {
+	return v.AppendSortable(nil), nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package semver_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/anttikivi/semver"
+)
+
+var (
+	_ sql.Scanner   = (*semver.Version)(nil)
+	_ driver.Valuer = (*semver.Version)(nil)
+	_ sql.Scanner   = (*semver.SortableVersion)(nil)
+	_ driver.Valuer = semver.SortableVersion{}
+)
+
+func TestVersionScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		src     any
+		want    string
+		wantErr bool
+	}{
+		{"1.2.3-beta+build", "1.2.3-beta+build", false},
+		{[]byte("v0.1.0"), "0.1.0", false},
+		{"1.2", "", true},
+		{nil, "0.0.0", false},
+		{int64(1), "", true},
+	}
+
+	for _, tt := range tests {
+		var v semver.Version
+
+		err := v.Scan(tt.src)
+		if tt.wantErr {
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Errorf("Scan(%v) = %v, want ErrInvalidVersion", tt.src, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Scan(%v) failed unexpectedly: %v", tt.src, err)
+
+			continue
+		}
+
+		if got := v.String(); got != tt.want {
+			t.Errorf("Scan(%v) = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestVersionValue(t *testing.T) {
+	t.Parallel()
+
+	got, err := semver.MustParse("v1.2.3-rc.1").Value()
+	if err != nil {
+		t.Fatalf("Value() failed unexpectedly: %v", err)
+	}
+
+	if got != "1.2.3-rc.1" {
+		t.Errorf("Value() = %v, want %q", got, "1.2.3-rc.1")
+	}
+
+	var v *semver.Version
+
+	if got, err = v.Value(); got != nil || err != nil {
+		t.Errorf("(*Version)(nil).Value() = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestSortableVersionScanValue(t *testing.T) {
+	t.Parallel()
+
+	in := semver.SortableVersion{Version: *semver.MustParse("1.2.3-alpha.1+exp")}
+
+	val, err := in.Value()
+	if err != nil {
+		t.Fatalf("Value() failed unexpectedly: %v", err)
+	}
+
+	var out semver.SortableVersion
+	if err = out.Scan(val); err != nil {
+		t.Fatalf("Scan(%v) failed unexpectedly: %v", val, err)
+	}
+
+	if !out.StrictEqual(&in.Version) {
+		t.Errorf("Scan(Value()) = %v, want %v", &out.Version, &in.Version)
+	}
+
+	if err = out.Scan(int64(1)); !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("Scan(%v) = %v, want ErrInvalidVersion", int64(1), err)
+	}
+}
+
+func TestScanNull(t *testing.T) {
+	t.Parallel()
+
+	var (
+		v *semver.Version
+		s *semver.SortableVersion
+	)
+
+	for _, src := range []driver.Valuer{v, s} {
+		// The driver converts a nil pointer into NULL.
+		val, err := driver.DefaultParameterConverter.ConvertValue(src)
+		if err != nil || val != nil {
+			t.Fatalf("ConvertValue(%T(nil)) = %v, %v, want nil, nil", src, val, err)
+		}
+
+		w := semver.MustParse("1.2.3-rc.1+build")
+		if err = w.Scan(val); err != nil {
+			t.Errorf("Version.Scan(nil) failed unexpectedly: %v", err)
+		}
+
+		if !w.StrictEqual(&semver.Version{}) {
+			t.Errorf("Version.Scan(nil) = %v, want the zero Version", w)
+		}
+
+		x := semver.SortableVersion{Version: *semver.MustParse("1.2.3-rc.1+build")}
+		if err = x.Scan(val); err != nil {
+			t.Errorf("SortableVersion.Scan(nil) failed unexpectedly: %v", err)
+		}
+
+		if !x.StrictEqual(&semver.Version{}) {
+			t.Errorf("SortableVersion.Scan(nil) = %v, want the zero Version", &x.Version)
+		}
+	}
+}
@@ -19,14 +19,7 @@
 
 package semver
 
-import "fmt"
-
-// Values for number mode.
-const (
-	dot numberMode = iota
-	end
-	loose
-)
+import "strconv"
 
 // Values for validation mode.
 const (
@@ -34,87 +27,97 @@ const (
 	lax
 )
 
-// A numberMode is a helper parameter type for parsing the numbers in
-// the version strings.
-type numberMode int
+// maxUint64 is the largest uint64 as a string. It is used for checking if
+// the numbers in the version strings overflow without converting them.
+const maxUint64 = "18446744073709551615"
 
 // A validationMode is a helper parameter type for telling if the validation
 // parser should be lax about the version number.
 type validationMode int
 
+// A scanError is the reason why the validation scanners rejected a version
+// string. Its zero value means that the scanned part of the version string is
+// valid. It is a plain value so that the scanners do not allocate.
+type scanError struct {
+	offset int
+	length int
+	kind   ErrorKind
+	comp   Component
+	failed bool
+}
+
 // IsValid reports whether s is a valid semantic version string. The version may
 // have a 'v' prefix.
 func IsValid(s string) bool {
-	return isValid(s, strict)
+	return !validate(s, strict).failed
 }
 
 // IsValidLax reports whether s is a valid semantic version string even if it is
 // only a partial version. In other words, this function reads `v1` and `v1.2`
 // as valid versions. The version may have a 'v' prefix.
 func IsValidLax(s string) bool {
-	return isValid(s, lax)
+	return !validate(s, lax).failed
 }
 
-func isValid(s string, mode validationMode) bool {
-	ok, pos := isStartValid(s)
-	if !ok {
-		return false
+// Validate checks whether s is a valid semantic version string and returns
+// a [*ParseError] that describes the problem if it is not. It returns nil for
+// the same strings that [IsValid] reports as valid, and it does not allocate
+// memory for them. The version may have a 'v' prefix.
+func Validate(s string) error {
+	if e := validate(s, strict); e.failed {
+		return e.parseError(s)
 	}
 
-	if ok, pos = isCoreValid(s, pos, mode); !ok {
-		return false
-	}
+	return nil
+}
 
-	if pos >= len(s) {
-		return true
+// ValidateLax checks whether s is a valid semantic version string even if it is
+// only a partial version, and returns a [*ParseError] that describes
+// the problem if it is not. It returns nil for the same strings that
+// [IsValidLax] reports as valid, and it does not allocate memory for them.
+// The version may have a 'v' prefix.
+func ValidateLax(s string) error {
+	if e := validate(s, lax); e.failed {
+		return e.parseError(s)
 	}
 
-	if len(s)-pos < 2 { //nolint:mnd // not enough characters left
-		return false
+	return nil
+}
+
+func validate(s string, mode validationMode) scanError {
+	pos, e := isStartValid(s)
+	if e.failed {
+		return e
 	}
 
-	if s[pos] != '-' && s[pos] != '+' {
-		return false
+	if pos, e = isCoreValid(s, pos, mode); e.failed {
+		return e
 	}
 
 	// Check the pre-release identifiers.
-	if s[pos] == '-' {
-		pos++
-
-		var ok bool
-		if ok, pos = isPrereleaseValid(s, pos); !ok {
-			return false
+	if pos < len(s) && s[pos] == '-' {
+		if pos, e = isPrereleaseValid(s, pos+1); e.failed {
+			return e
 		}
 	}
 
-	if pos >= len(s) {
-		return true
+	if pos < len(s) && s[pos] == '+' {
+		return isBuildMetadataValid(s, pos+1)
 	}
 
-	if s[pos] == '+' {
-		pos++
-
-		if ok := isBuildMetadataValid(s, pos); !ok {
-			return false
-		}
-	}
-
-	return true
+	return scanError{}
 }
 
-// isStartValid checks if the start of the version string is valid. The function
-// checks the prefix and that the string actually contains numbers. It returns
-// the status of the check and the current index.
-func isStartValid(ver string) (bool, int) {
+func isStartValid(ver string) (int, scanError) {
 	if ver == "" {
-		return false, 0
+		return 0, newScanError(0, 0, KindEmpty, ComponentCore)
 	}
 
 	pos := 0
 
 	c := ver[0]
 	if !isDigit(c) && c != 'v' {
-		return false, pos
+		return pos, newScanError(0, 1, KindBadPrefix, ComponentPrefix)
 	}
 
 	if c == 'v' {
@@ -122,202 +125,140 @@ func isStartValid(ver string) (bool, int) {
 	}
 
 	if pos == len(ver) {
-		return false, pos
+		return pos, newScanError(pos, 0, KindEmptyIdentifier, ComponentCore)
 	}
 
-	return true, pos
+	return pos, scanError{}
 }
 
-func isCoreValid(s string, pos int, mode validationMode) (bool, int) {
-	var ok bool
+func isCoreValid(s string, pos int, mode validationMode) (int, scanError) {
+	n := 0
 
-	numMode := dot
-	if mode == lax {
-		numMode = loose
-	}
+	for {
+		start := pos
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
 
-	if ok, pos = isVersionNumberValid(s, pos, numMode); !ok {
-		return false, pos
-	}
+		if n == 3 { //nolint:mnd // <major>.<minor>.<patch>
+			return pos, newScanError(start-1, pos-start+1, KindTooManyCoreNumbers, ComponentCore)
+		}
 
-	if pos >= len(s) {
-		return mode == lax, pos
-	}
+		if pos == start {
+			return pos, newScanError(start, 0, KindEmptyIdentifier, ComponentCore)
+		}
 
-	if mode == lax && (s[pos] == '-' || s[pos] == '+') {
-		return true, pos
-	}
+		if pos-start > 1 && s[start] == '0' {
+			return pos, newScanError(start, pos-start, KindLeadingZero, ComponentCore)
+		}
 
-	if s[pos] != '.' {
-		return false, pos
-	}
+		if overflowsUint64(s[start:pos]) {
+			return pos, newScanError(start, pos-start, KindOverflow, ComponentCore)
+		}
 
-	pos++
-	if ok, pos = isVersionNumberValid(s, pos, numMode); !ok {
-		return false, pos
-	}
+		n++
 
-	if pos >= len(s) {
-		return mode == lax, pos
-	}
+		if pos >= len(s) || s[pos] != '.' {
+			break
+		}
 
-	if mode == lax && (s[pos] == '-' || s[pos] == '+') {
-		return true, pos
+		pos++
 	}
 
-	if s[pos] != '.' {
-		return false, pos
+	if mode == strict && n < 3 { //nolint:mnd // <major>.<minor>.<patch>
+		return pos, newScanError(pos, 0, KindTooFewCoreNumbers, ComponentCore)
 	}
 
-	if mode == strict {
-		numMode = end
+	if pos < len(s) && s[pos] != '-' && s[pos] != '+' {
+		return pos, newScanError(pos, 1, KindInvalidCharacter, ComponentCore)
 	}
 
-	pos++
-	if ok, pos = isVersionNumberValid(s, pos, numMode); !ok {
-		return false, pos
-	}
+	return pos, scanError{}
+}
 
-	// Only major and minor version numbers are provided.
-	if pos >= len(s) {
-		return true, pos
-	}
+func isPrereleaseValid(ver string, pos int) (int, scanError) {
+	for {
+		start := pos
+		num := true
 
-	return true, pos
-}
+		for ; pos < len(ver) && ver[pos] != '.' && ver[pos] != '+'; pos++ {
+			c := ver[pos]
+			if !isIdentifierCharacter(c) {
+				return pos, newScanError(pos, 1, KindInvalidCharacter, ComponentPrerelease)
+			}
 
-// isVersionNumberValid reports whether the next number in the version string is
-// valid. It returns the status of the check and the new position. If final is
-// set to true, the number may end in a "-" or a "+" instead of a ".".
-func isVersionNumberValid(s string, pos int, mode numberMode) (bool, int) {
-	start := pos
-
-	// Check that every number before the next character that ends it is
-	// a digit.
-	switch mode {
-	case dot:
-		for ; pos < len(s) && s[pos] != '.'; pos++ {
-			if !isDigit(s[pos]) {
-				return false, pos
+			if !isDigit(c) {
+				num = false
 			}
 		}
-	case end:
-		for ; pos < len(s) && s[pos] != '-' && s[pos] != '+'; pos++ {
-			if !isDigit(s[pos]) {
-				return false, pos
-			}
+
+		if pos == start {
+			return pos, newScanError(start, 0, KindEmptyIdentifier, ComponentPrerelease)
 		}
-	case loose:
-		for ; pos < len(s) && s[pos] != '.' && s[pos] != '-' && s[pos] != '+'; pos++ {
-			if !isDigit(s[pos]) {
-				return false, pos
-			}
+
+		// If the identifier with a leading zero is a number longer than
+		// one character, the version is invalid.
+		if num && pos-start > 1 && ver[start] == '0' {
+			return pos, newScanError(start, pos-start, KindLeadingZero, ComponentPrerelease)
 		}
-	default:
-		panic(fmt.Sprintf("invalid number mode: %d", mode))
-	}
 
-	if pos == start {
-		return false, pos
-	}
+		if num && overflowsUint64(ver[start:pos]) {
+			return pos, newScanError(start, pos-start, KindOverflow, ComponentPrerelease)
+		}
 
-	if pos-start > 1 && s[start] == '0' {
-		return false, pos
-	}
+		if pos >= len(ver) || ver[pos] != '.' {
+			return pos, scanError{}
+		}
 
-	return true, pos
+		pos++
+	}
 }
 
-func isPrereleaseValid(ver string, pos int) (bool, int) { //nolint:cyclop,gocognit // no problem
-	num := true
-	zero := false
-	currentLen := 0
-
-	for ; pos < len(ver) && ver[pos] != '+'; pos++ {
-		b := ver[pos]
-		// If the character is a dot, start a new identifier.
-		if b == '.' {
-			if currentLen == 0 {
-				return false, pos
-			}
+func isBuildMetadataValid(ver string, pos int) scanError {
+	for {
+		start := pos
 
-			// If the identifier with a leading zero is a number longer than
-			// one character, the version is invalid.
-			if zero && num && currentLen > 1 {
-				return false, pos
+		for ; pos < len(ver) && ver[pos] != '.'; pos++ {
+			if !isIdentifierCharacter(ver[pos]) {
+				return newScanError(pos, 1, KindInvalidCharacter, ComponentBuild)
 			}
-
-			num = true
-			zero = false
-			currentLen = 0
-
-			if pos+1 >= len(ver) || ver[pos+1] == '+' || ver[pos+1] == '.' {
-				return false, pos + 1
-			}
-
-			continue
-		}
-
-		if b == '0' && currentLen == 0 {
-			zero = true
 		}
 
-		// If the identifier is still a number but we encounter a non-digit
-		// character, the identifier is no longer a number.
-		if num && ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z') || b == '-' {
-			num = false
+		if pos == start {
+			return newScanError(start, 0, KindEmptyIdentifier, ComponentBuild)
 		}
 
-		// Otherwise just check that the character is valid.
-		if ('A' > b || b > 'Z') && ('a' > b || b > 'z') && ('0' > b || b > '9') && b != '-' {
-			return false, pos
+		if pos >= len(ver) {
+			return scanError{}
 		}
 
-		currentLen++
-	}
-
-	// If the identifier with a leading zero is a number longer than
-	// one character, the version is invalid.
-	if zero && num && currentLen > 1 {
-		return false, pos
+		pos++
 	}
+}
 
-	if currentLen == 0 {
-		return false, pos
+// newScanError returns a failed scanError with the given details.
+func newScanError(offset, length int, kind ErrorKind, comp Component) scanError {
+	return scanError{
+		offset: offset,
+		length: length,
+		kind:   kind,
+		comp:   comp,
+		failed: true,
 	}
-
-	return true, pos
 }
 
-func isBuildMetadataValid(ver string, pos int) bool {
-	if pos >= len(ver) {
-		return false
+// parseError converts the scanError for the version string s to a ParseError.
+func (e scanError) parseError(s string) *ParseError {
+	pe := newParseError(s, e.offset, e.length, e.kind, e.comp)
+	if e.kind == KindOverflow {
+		pe.Err = strconv.ErrRange
 	}
 
-	l := 0
-
-	for ; pos < len(ver); pos++ {
-		c := ver[pos]
-		if c == '.' {
-			if l == 0 {
-				return false
-			}
-
-			l = 0
-
-			if pos == len(ver)-1 {
-				return false
-			}
-
-			continue
-		}
-
-		if !isIdentifierCharacter(c) {
-			return false
-		}
-
-		l++
-	}
+	return pe
+}
 
-	return l > 0
+// overflowsUint64 reports whether the number in the string of digits s, that
+// has no leading zeros, does not fit in uint64.
+func overflowsUint64(s string) bool {
+	return len(s) > len(maxUint64) || (len(s) == len(maxUint64) && s > maxUint64)
 }
@@ -19,7 +19,10 @@
 
 package semver
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 var (
 	isValidRegexTests []validationTestCase
@@ -80,6 +83,14 @@ func BenchmarkIsValidShorter(b *testing.B) {
 	}
 }
 
+func BenchmarkValidate(b *testing.B) {
+	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
+
+	for b.Loop() {
+		_ = Validate(test)
+	}
+}
+
 func BenchmarkIsValidRegex(b *testing.B) {
 	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
 
@@ -98,6 +109,17 @@ func BenchmarkIsValidRegexShorter(b *testing.B) {
 	}
 }
 
+func FuzzValidate(f *testing.F) {
+	for _, tt := range baseTests {
+		f.Add(tt.v)
+	}
+
+	f.Fuzz(func(t *testing.T, a string) {
+		testValidateMatchesParse(t, a, Validate, Parse)
+		testValidateMatchesParse(t, a, ValidateLax, ParseLax)
+	})
+}
+
 func TestIsValid(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +158,88 @@ func TestIsValidLax(t *testing.T) {
 	}
 }
 
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range parserTests {
+		name := tt.v
+		if name == "" {
+			name = emptyName
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testValidateMatchesParse(t, tt.v, Validate, Parse)
+		})
+	}
+}
+
+func TestValidateLax(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range laxParserTests {
+		name := tt.v
+		if name == "" {
+			name = emptyName
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testValidateMatchesParse(t, tt.v, ValidateLax, ParseLax)
+		})
+	}
+}
+
+func TestValidateAllocs(t *testing.T) {
+	test := "0.1.0-alpha.24+sha.19031c2.darwin.amd64"
+
+	if n := testing.AllocsPerRun(100, func() { _ = Validate(test) }); n != 0 {
+		t.Errorf("Validate(%q) allocated %v times, want 0", test, n)
+	}
+
+	if n := testing.AllocsPerRun(100, func() { _ = ValidateLax(test) }); n != 0 {
+		t.Errorf("ValidateLax(%q) allocated %v times, want 0", test, n)
+	}
+}
+
+// testValidateMatchesParse checks that validate reports the same problem for s
+// as parse does.
+func testValidateMatchesParse(
+	t *testing.T,
+	s string,
+	validate func(string) error,
+	parse func(string) (*Version, error),
+) {
+	t.Helper()
+
+	err := validate(s)
+	_, perr := parse(s)
+
+	if (err == nil) != (perr == nil) {
+		t.Fatalf("validate(%q) = %v, but parse(%q) returned %v", s, err, s, perr)
+	}
+
+	if err == nil {
+		return
+	}
+
+	var got, want *ParseError
+	if !errors.As(err, &got) {
+		t.Fatalf("validate(%q) = %v, want *ParseError", s, err)
+	}
+
+	if !errors.As(perr, &want) {
+		t.Fatalf("Setup error: parse(%q) returned %v, want *ParseError", s, perr)
+	}
+
+	if got.Offset != want.Offset || got.Length != want.Length || got.Kind != want.Kind ||
+		got.Component != want.Component {
+		t.Errorf("validate(%q) = %v, want %v", s, got, want)
+	}
+}
+
 func isValidRegex(v string) {
 	_ = versionRegex.MatchString(v)
 }
@@ -0,0 +1,473 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+/*
+Package vers parses, normalizes, and evaluates version range specifiers in
+the "vers" syntax of the Package-URL project.
+
+The syntax is specified at
+https://github.com/package-url/purl-spec/blob/main/VERSION-RANGE-SPEC.rst and it
+is used for the version ranges of, for example, CycloneDX and SPDX documents.
+A range has the form "vers:<scheme>/<constraints>", where the constraints are
+separated by pipe characters and consist of a comparator and a version:
+
+	vers:npm/>=1.0.0|<2.0.0|!=1.5.0
+
+This package supports the schemes "semver", "npm", and "golang", all of which
+use semantic versions, and compares the versions using
+[semver.Version.Compare]. [Parse] validates the range and converts it into
+the canonical form, so [Range.String] returns the same string for every range
+that has the same constraints:
+
+	r, err := vers.Parse("vers:NPM/ <2.0.0 | >=1.0.0 ")
+	r.String()                            // "vers:npm/>=1.0.0|<2.0.0"
+	r.Contains(semver.MustParse("1.2.0")) // true
+*/
+package vers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/anttikivi/semver"
+)
+
+// The supported versioning schemes.
+const (
+	// SchemeSemVer is the scheme of the versions that follow the semantic
+	// versioning specification. The versions may not have a 'v' prefix.
+	SchemeSemVer = "semver"
+
+	// SchemeNPM is the scheme of npm packages. The versions may have a 'v'
+	// prefix, but it is removed in the canonical form.
+	SchemeNPM = "npm"
+
+	// SchemeGo is the scheme of Go modules. The versions must have a 'v'
+	// prefix and they are written in the canonical form of Go module versions.
+	SchemeGo = "golang"
+)
+
+// The comparators of the constraints.
+const (
+	// Equal matches the version of the constraint. It is the default
+	// comparator and it is omitted from the canonical form.
+	Equal Comparator = "="
+
+	// NotEqual excludes the version of the constraint from the range.
+	NotEqual Comparator = "!="
+
+	// Less matches the versions that are lower than the version of
+	// the constraint.
+	Less Comparator = "<"
+
+	// LessEqual matches the versions that are lower than or equal to
+	// the version of the constraint.
+	LessEqual Comparator = "<="
+
+	// Greater matches the versions that are greater than the version of
+	// the constraint.
+	Greater Comparator = ">"
+
+	// GreaterEqual matches the versions that are greater than or equal to
+	// the version of the constraint.
+	GreaterEqual Comparator = ">="
+)
+
+// prefix is the URI scheme of the version range specifiers.
+const prefix = "vers:"
+
+// ErrInvalidRange is returned when a version range specifier cannot be parsed
+// or its constraints are not valid.
+var ErrInvalidRange = errors.New("invalid vers range")
+
+// A Comparator is the comparison operator of a constraint, like ">=".
+type Comparator string
+
+// A Constraint is a single constraint of a range, like ">=1.0.0".
+type Constraint struct {
+	// Comparator is the comparison operator of the constraint.
+	Comparator Comparator
+
+	// Version is the version that the comparator is applied to.
+	Version *semver.Version
+}
+
+// A Range is a version range specifier. The constraints of a Range created
+// using [Parse] or [New] are in the canonical form: they are sorted by their
+// versions, and they are in the order that the specification requires.
+//
+// A Range without constraints contains every version and it is written as
+// the wildcard "*". A Range with only [NotEqual] constraints contains every
+// version except the excluded ones.
+type Range struct {
+	// Scheme is the versioning scheme of the range, like "npm".
+	Scheme string
+
+	// Constraints are the constraints of the range.
+	Constraints []Constraint
+}
+
+// New returns a Range with the given scheme and constraints. It lowercases
+// the scheme, sorts the constraints into the canonical order, and checks that
+// they are valid. If no constraints are given, the Range contains every
+// version.
+func New(scheme string, constraints ...Constraint) (*Range, error) {
+	scheme = strings.ToLower(scheme)
+	if !isScheme(scheme) {
+		return nil, fmt.Errorf("%w: unsupported scheme %q", ErrInvalidRange, scheme)
+	}
+
+	cs := slices.Clone(constraints)
+
+	for _, c := range cs {
+		if c.Version == nil {
+			return nil, fmt.Errorf("%w: constraint without a version", ErrInvalidRange)
+		}
+
+		if !isComparator(c.Comparator) {
+			return nil, fmt.Errorf("%w: invalid comparator %q", ErrInvalidRange, c.Comparator)
+		}
+	}
+
+	slices.SortStableFunc(cs, func(a, b Constraint) int {
+		return a.Version.Compare(b.Version)
+	})
+
+	if err := validate(cs); err != nil {
+		return nil, err
+	}
+
+	return &Range{Scheme: scheme, Constraints: cs}, nil
+}
+
+// Parse parses a version range specifier like "vers:npm/>=1.0.0|<2.0.0". It
+// removes the whitespace in s, lowercases the scheme, and unescapes
+// the percent-encoded versions before parsing the constraints. The returned
+// Range is in the canonical form.
+func Parse(s string) (*Range, error) {
+	t := strings.Join(strings.Fields(s), "")
+
+	if len(t) < len(prefix) || !strings.EqualFold(t[:len(prefix)], prefix) {
+		return nil, fmt.Errorf("%w: %q does not start with %q", ErrInvalidRange, s, prefix)
+	}
+
+	scheme, list, ok := strings.Cut(t[len(prefix):], "/")
+	if !ok || scheme == "" {
+		return nil, fmt.Errorf("%w: missing scheme in %q", ErrInvalidRange, s)
+	}
+
+	scheme = strings.ToLower(scheme)
+	if !isScheme(scheme) {
+		return nil, fmt.Errorf("%w: unsupported scheme %q", ErrInvalidRange, scheme)
+	}
+
+	if list == "" {
+		return nil, fmt.Errorf("%w: missing constraints in %q", ErrInvalidRange, s)
+	}
+
+	if list == "*" {
+		return &Range{Scheme: scheme, Constraints: nil}, nil
+	}
+
+	parts := strings.Split(list, "|")
+	cs := make([]Constraint, 0, len(parts))
+
+	for _, part := range parts {
+		c, err := parseConstraint(scheme, part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", s, err)
+		}
+
+		cs = append(cs, c)
+	}
+
+	r, err := New(scheme, cs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", s, err)
+	}
+
+	return r, nil
+}
+
+// Contains reports whether v is in r. It uses the algorithm of
+// the specification, comparing the versions using [semver.Version.Compare].
+// A range that consists only of [NotEqual] constraints contains every version
+// except the excluded ones.
+func (r *Range) Contains(v *semver.Version) bool {
+	// The steps below follow "Checking if a version is contained within
+	// a range" in the specification. First, a "*" range contains every
+	// version.
+	if len(r.Constraints) == 0 {
+		return true
+	}
+
+	// Next, if v is equal to the version of a constraint, v is in r when
+	// the comparator is "=", "<=", or ">=", and not in r when it is "!=".
+	for _, c := range r.Constraints {
+		if v.Compare(c.Version) != 0 {
+			continue
+		}
+
+		switch c.Comparator {
+		case Equal, LessEqual, GreaterEqual:
+			return true
+		case NotEqual:
+			return false
+		case Less, Greater:
+		}
+	}
+
+	// Then the constraints are split into a list of the "=" and "!="
+	// constraints and a list of the rest, and only the second list is checked
+	// further. If the second list is empty, v is equal to none of
+	// the versions, so it matches no "=" constraint but satisfies every "!="
+	// constraint. Thus, v is in r exactly when r has only "!=" constraints.
+	cs := bounds(r.Constraints)
+	if len(cs) == 0 {
+		for _, c := range r.Constraints {
+			if c.Comparator != NotEqual {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// Finally, v is in r if it is below the first bound, above the last
+	// bound, or between a pair of contiguous ">" and "<" bounds.
+	for i, c := range cs {
+		switch {
+		case i == 0 && isLess(c.Comparator) && v.Compare(c.Version) < 0:
+			return true
+		case i == len(cs)-1 && isGreater(c.Comparator) && v.Compare(c.Version) > 0:
+			return true
+		case i > 0 && isGreater(cs[i-1].Comparator) && isLess(c.Comparator) &&
+			v.Compare(cs[i-1].Version) > 0 && v.Compare(c.Version) < 0:
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the canonical string representation of r, like
+// "vers:npm/>=1.0.0|<2.0.0".
+func (r *Range) String() string {
+	b, _ := r.AppendText(nil)
+
+	return string(b)
+}
+
+// AppendText implements the [encoding.TextAppender] interface. It appends
+// the string representation of r, as returned by [Range.String], to b.
+func (r *Range) AppendText(b []byte) ([]byte, error) {
+	b = append(b, prefix...)
+	b = append(b, r.Scheme...)
+	b = append(b, '/')
+
+	if len(r.Constraints) == 0 {
+		return append(b, '*'), nil
+	}
+
+	for i, c := range r.Constraints {
+		if i > 0 {
+			b = append(b, '|')
+		}
+
+		if c.Comparator != Equal {
+			b = append(b, c.Comparator...)
+		}
+
+		if r.Scheme == SchemeGo {
+			b = append(b, 'v')
+		}
+
+		b, _ = c.Version.AppendText(b)
+	}
+
+	return b, nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The text
+// form of r is the same as the one returned by [Range.String].
+func (r *Range) MarshalText() ([]byte, error) {
+	return r.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. It parses
+// the text using [Parse].
+func (r *Range) UnmarshalText(text []byte) error {
+	s, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = *s
+
+	return nil
+}
+
+// parseConstraint parses a single constraint of a range with the given
+// scheme.
+func parseConstraint(scheme, s string) (Constraint, error) {
+	if s == "" {
+		return Constraint{}, fmt.Errorf("%w: empty constraint", ErrInvalidRange)
+	}
+
+	if s == "*" {
+		return Constraint{}, fmt.Errorf("%w: \"*\" must be the only constraint", ErrInvalidRange)
+	}
+
+	c := Constraint{Comparator: Equal, Version: nil}
+
+	// The two-character comparators must be checked first.
+	for _, cmp := range []Comparator{NotEqual, LessEqual, GreaterEqual, Less, Greater, Equal} {
+		if rest, ok := strings.CutPrefix(s, string(cmp)); ok {
+			c.Comparator = cmp
+			s = rest
+
+			break
+		}
+	}
+
+	if s == "" {
+		return Constraint{}, fmt.Errorf("%w: constraint without a version", ErrInvalidRange)
+	}
+
+	s, err := url.PathUnescape(s)
+	if err != nil {
+		return Constraint{}, fmt.Errorf("%w: invalid escaping in version: %w", ErrInvalidRange, err)
+	}
+
+	if c.Version, err = parseVersion(scheme, s); err != nil {
+		return Constraint{}, err
+	}
+
+	return c, nil
+}
+
+// parseVersion parses a version of the given scheme.
+func parseVersion(scheme, s string) (*semver.Version, error) {
+	var (
+		v   *semver.Version
+		err error
+	)
+
+	switch scheme {
+	case SchemeSemVer:
+		v, err = (&semver.Parser{Prefixes: []string{}}).Parse(s)
+	case SchemeNPM:
+		v, err = semver.Parse(s)
+	default:
+		if !semver.IsGoModuleValid(s) {
+			return nil, fmt.Errorf("%w: invalid Go module version %q", ErrInvalidRange, s)
+		}
+
+		v, err = semver.ParseBig(semver.CanonicalModule(s))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+
+	return v, nil
+}
+
+// validate checks that the sorted constraints are valid according to
+// the specification: the versions must be unique and, when the [NotEqual]
+// constraints are ignored, an [Equal] constraint may be followed only by
+// the [Equal], [Greater], and [GreaterEqual] constraints, and the lesser and
+// greater comparators must alternate.
+func validate(cs []Constraint) error {
+	for i := 1; i < len(cs); i++ {
+		if cs[i-1].Version.Compare(cs[i].Version) == 0 {
+			return fmt.Errorf("%w: duplicate version %s", ErrInvalidRange, cs[i].Version)
+		}
+	}
+
+	var prev Comparator
+
+	for _, c := range cs {
+		if c.Comparator == NotEqual {
+			continue
+		}
+
+		if prev == Equal && isLess(c.Comparator) {
+			return fmt.Errorf(
+				"%w: %q constraint after an equality constraint",
+				ErrInvalidRange,
+				c.Comparator,
+			)
+		}
+
+		prev = c.Comparator
+	}
+
+	cs = bounds(cs)
+
+	for i := 1; i < len(cs); i++ {
+		if isLess(cs[i-1].Comparator) == isLess(cs[i].Comparator) {
+			return fmt.Errorf(
+				"%w: %q constraint after a %q constraint",
+				ErrInvalidRange,
+				cs[i].Comparator,
+				cs[i-1].Comparator,
+			)
+		}
+	}
+
+	return nil
+}
+
+// bounds returns the constraints that have one of the comparators [Less],
+// [LessEqual], [Greater], and [GreaterEqual].
+func bounds(cs []Constraint) []Constraint {
+	return slices.DeleteFunc(slices.Clone(cs), func(c Constraint) bool {
+		return c.Comparator == Equal || c.Comparator == NotEqual
+	})
+}
+
+// isComparator reports whether c is one of the comparators of
+// the specification.
+func isComparator(c Comparator) bool {
+	switch c {
+	case Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// isGreater reports whether c is [Greater] or [GreaterEqual].
+func isGreater(c Comparator) bool {
+	return c == Greater || c == GreaterEqual
+}
+
+// isLess reports whether c is [Less] or [LessEqual].
+func isLess(c Comparator) bool {
+	return c == Less || c == LessEqual
+}
+
+// isScheme reports whether scheme is one of the supported schemes.
+func isScheme(scheme string) bool {
+	return scheme == SchemeSemVer || scheme == SchemeNPM || scheme == SchemeGo
+}
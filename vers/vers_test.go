@@ -0,0 +1,291 @@
+// Copyright (c) 2026 Antti Kivi
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package vers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anttikivi/semver"
+	"github.com/anttikivi/semver/vers"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"vers:npm/>=1.0.0|<2.0.0", "vers:npm/>=1.0.0|<2.0.0"},
+		{"vers:npm/<2.0.0|>=1.0.0", "vers:npm/>=1.0.0|<2.0.0"},
+		{" VERS:NPM / >=1.0.0 | <2.0.0 ", "vers:npm/>=1.0.0|<2.0.0"},
+		{"vers:npm/>=1.0.0|<2.0.0|!=1.5.0", "vers:npm/>=1.0.0|!=1.5.0|<2.0.0"},
+		{"vers:npm/=1.0.0|2.0.0", "vers:npm/1.0.0|2.0.0"},
+		{"vers:npm/v1.2.3", "vers:npm/1.2.3"},
+		{"vers:npm/*", "vers:npm/*"},
+		{"vers:semver/1.0.0-rc.1+build.5", "vers:semver/1.0.0-rc.1+build.5"},
+		{"vers:semver/1.0.0%2Bbuild", "vers:semver/1.0.0+build"},
+		{"vers:semver/<1.0.0|>=2.0.0", "vers:semver/<1.0.0|>=2.0.0"},
+		{"vers:semver/1.0.0|>=2.0.0|<3.0.0|4.0.0", "vers:semver/1.0.0|>=2.0.0|<3.0.0|4.0.0"},
+		{"vers:semver/!=1.0.0|!=2.0.0", "vers:semver/!=1.0.0|!=2.0.0"},
+		{"vers:golang/>=v1.2|<v2.0.0+incompatible", "vers:golang/>=v1.2.0|<v2.0.0+incompatible"},
+		{"vers:golang/v0.0.0-20191109021931-daa7c04131f5", "vers:golang/v0.0.0-20191109021931-daa7c04131f5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := vers.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.input, err)
+			}
+
+			if got := r.String(); got != tt.want {
+				t.Errorf("Parse(%q).String() = %q, want %q", tt.input, got, tt.want)
+			}
+
+			s, err := vers.Parse(tt.want)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.want, err)
+			}
+
+			if got := s.String(); got != tt.want {
+				t.Errorf("Parse(%q).String() = %q, want the same", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"",
+		"npm/1.0.0",
+		"vers:",
+		"vers:npm",
+		"vers:/1.0.0",
+		"vers:npm/",
+		"vers:pypi/1.0",
+		"vers:npm/1.0.0|",
+		"vers:npm/|1.0.0",
+		"vers:npm/*|1.0.0",
+		"vers:npm/>=",
+		"vers:npm/~1.0.0",
+		"vers:npm/1.0",
+		"vers:npm/1.0.0%zz",
+		"vers:semver/v1.0.0",
+		"vers:golang/1.0.0",
+		"vers:npm/1.0.0|=1.0.0+build",
+		"vers:npm/>=1.0.0|<1.0.0",
+		"vers:npm/>=1.0.0|>=2.0.0",
+		"vers:npm/<1.0.0|<2.0.0",
+		"vers:npm/>=1.0.0|2.0.0|<3.0.0",
+		"vers:npm/1.0.0|<2.0.0",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := vers.Parse(tt)
+			if err == nil {
+				t.Fatalf("Parse(%q) = %q, want an error", tt, r)
+			}
+
+			if !errors.Is(err, vers.ErrInvalidRange) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidRange", tt, err)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	r, err := vers.New(
+		"NPM",
+		vers.Constraint{Comparator: vers.Less, Version: semver.MustParse("2.0.0")},
+		vers.Constraint{Comparator: vers.NotEqual, Version: semver.MustParse("1.5.0")},
+		vers.Constraint{Comparator: vers.GreaterEqual, Version: semver.MustParse("1.0.0")},
+	)
+	if err != nil {
+		t.Fatalf("New() returned an error: %v", err)
+	}
+
+	if got, want := r.String(), "vers:npm/>=1.0.0|!=1.5.0|<2.0.0"; got != want {
+		t.Errorf("New().String() = %q, want %q", got, want)
+	}
+
+	r, err = vers.New(vers.SchemeGo)
+	if err != nil {
+		t.Fatalf("New() returned an error: %v", err)
+	}
+
+	if got, want := r.String(), "vers:golang/*"; got != want {
+		t.Errorf("New().String() = %q, want %q", got, want)
+	}
+
+	invalid := [][]vers.Constraint{
+		{{Comparator: vers.Equal, Version: nil}},
+		{{Comparator: "~", Version: semver.MustParse("1.0.0")}},
+		{
+			{Comparator: vers.Greater, Version: semver.MustParse("1.0.0")},
+			{Comparator: vers.Greater, Version: semver.MustParse("2.0.0")},
+		},
+	}
+
+	for _, cs := range invalid {
+		if _, err := vers.New(vers.SchemeNPM, cs...); !errors.Is(err, vers.ErrInvalidRange) {
+			t.Errorf("New(%v) error = %v, want ErrInvalidRange", cs, err)
+		}
+	}
+
+	if _, err := vers.New("pypi"); !errors.Is(err, vers.ErrInvalidRange) {
+		t.Errorf("New(\"pypi\") error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		in    []string
+		out   []string
+	}{
+		{
+			"vers:npm/*",
+			[]string{"0.0.0-0", "1.0.0", "99.0.0"},
+			nil,
+		},
+		{
+			"vers:npm/>=1.0.0|<2.0.0|!=1.5.0",
+			[]string{"1.0.0", "1.4.9", "1.5.1", "2.0.0-rc.1"},
+			[]string{"0.9.0", "1.0.0-rc.1", "1.5.0", "1.5.0+build", "2.0.0"},
+		},
+		{
+			"vers:semver/1.0.0|2.0.0",
+			[]string{"1.0.0", "2.0.0", "2.0.0+build"},
+			[]string{"1.5.0", "3.0.0"},
+		},
+		{
+			"vers:semver/<1.0.0",
+			[]string{"0.0.0", "0.9.0", "1.0.0-rc.1"},
+			[]string{"1.0.0", "2.0.0"},
+		},
+		{
+			"vers:semver/<=1.0.0",
+			[]string{"0.9.0", "1.0.0"},
+			[]string{"1.0.1"},
+		},
+		{
+			"vers:semver/>1.0.0",
+			[]string{"1.0.1", "2.0.0"},
+			[]string{"0.9.0", "1.0.0"},
+		},
+		{
+			"vers:semver/<1.0.0|>=2.0.0|<3.0.0|>4.0.0",
+			[]string{"0.1.0", "2.0.0", "2.9.0", "4.0.1"},
+			[]string{"1.0.0", "1.5.0", "3.0.0", "4.0.0"},
+		},
+		{
+			"vers:semver/1.0.0|>=2.0.0|<3.0.0|4.0.0",
+			[]string{"1.0.0", "2.5.0", "4.0.0"},
+			[]string{"0.9.0", "1.5.0", "3.0.0", "3.5.0", "5.0.0"},
+		},
+		{
+			"vers:npm/!=1.5.0",
+			[]string{"0.0.0", "1.0.0", "1.4.9", "1.5.0-rc.1", "1.5.1", "2.0.0"},
+			[]string{"1.5.0", "1.5.0+build"},
+		},
+		{
+			"vers:semver/!=1.0.0|!=2.0.0",
+			[]string{"0.1.0", "1.5.0", "3.0.0"},
+			[]string{"1.0.0", "2.0.0"},
+		},
+		{
+			"vers:semver/1.0.0|!=2.0.0",
+			[]string{"1.0.0"},
+			[]string{"2.0.0", "3.0.0"},
+		},
+		{
+			"vers:golang/>=v1.2.0|<v2.0.0+incompatible",
+			[]string{"1.2.0", "1.9.9"},
+			[]string{"1.1.0", "2.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := vers.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.input, err)
+			}
+
+			for _, s := range tt.in {
+				if !r.Contains(semver.MustParse(s)) {
+					t.Errorf("%q.Contains(%q) = false, want true", tt.input, s)
+				}
+			}
+
+			for _, s := range tt.out {
+				if r.Contains(semver.MustParse(s)) {
+					t.Errorf("%q.Contains(%q) = true, want false", tt.input, s)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeJSON(t *testing.T) {
+	t.Parallel()
+
+	var doc struct {
+		Range *vers.Range `json:"range"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"range":"vers:npm/<2.0.0|>=1.0.0"}`), &doc); err != nil {
+		t.Fatalf("json.Unmarshal() returned an error: %v", err)
+	}
+
+	var sb strings.Builder
+
+	enc := json.NewEncoder(&sb)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(doc); err != nil {
+		t.Fatalf("Encode() returned an error: %v", err)
+	}
+
+	if got, want := sb.String(), `{"range":"vers:npm/>=1.0.0|<2.0.0"}`+"\n"; got != want {
+		t.Errorf("Encode() = %q, want %q", got, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"range":"vers:npm/~1.0.0"}`), &doc); !errors.Is(err, vers.ErrInvalidRange) {
+		t.Errorf("json.Unmarshal() error = %v, want ErrInvalidRange", err)
+	}
+}